@@ -0,0 +1,40 @@
+data "pangolin_blueprint_document" "apps" {
+  proxy_resource {
+    key         = "grafana"
+    name        = "Grafana"
+    protocol    = "http"
+    full_domain = "grafana.example.com"
+
+    auth {
+      sso_enabled = true
+      sso_roles   = ["Member"]
+    }
+
+    rule {
+      action = "allow"
+      match  = "cidr"
+      value  = "10.0.0.0/8"
+    }
+
+    target {
+      site     = "home-lab"
+      hostname = "grafana"
+      port     = 3000
+      method   = "http"
+    }
+  }
+
+  private_resource {
+    key         = "db"
+    name        = "Database"
+    mode        = "host"
+    site        = "home-lab"
+    destination = "db.internal"
+    tcp_ports   = "5432"
+  }
+}
+
+resource "pangolin_blueprint" "apps" {
+  org_id  = "my-org"
+  content = data.pangolin_blueprint_document.apps.json
+}
@@ -0,0 +1,24 @@
+data "pangolin_domain" "main" {
+  org_id      = "my-org"
+  base_domain = "example.com"
+}
+
+resource "pangolin_resource" "web" {
+  org_id    = "my-org"
+  name      = "Web"
+  protocol  = "tcp"
+  http      = true
+  subdomain = "web"
+  domain_id = data.pangolin_domain.main.id
+}
+
+# Feed the records Pangolin expects into your DNS provider.
+resource "cloudflare_dns_record" "pangolin" {
+  for_each = { for r in data.pangolin_domain.main.dns_records : "${r.type}-${r.name}-${r.value}" => r }
+
+  zone_id = var.cloudflare_zone_id
+  type    = each.value.type
+  name    = each.value.name
+  content = each.value.value
+  ttl     = 1
+}
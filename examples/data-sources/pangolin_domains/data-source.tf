@@ -0,0 +1,7 @@
+data "pangolin_domains" "all" {
+  org_id = "my-org"
+}
+
+output "verified_domains" {
+  value = [for d in data.pangolin_domains.all.domains : d.base_domain if d.verified]
+}
@@ -0,0 +1,12 @@
+data "pangolin_request_analytics" "last_hour" {
+  org_id = "your-org-id"
+  since  = "1h"
+}
+
+# Fail the run when a rule change starts blocking too much traffic.
+check "blocked_volume" {
+  assert {
+    condition     = data.pangolin_request_analytics.last_hour.blocked_ratio < 0.2
+    error_message = "More than 20% of requests were blocked in the last hour."
+  }
+}
@@ -0,0 +1,10 @@
+data "pangolin_request_logs" "blocked_today" {
+  org_id      = "your-org-id"
+  since       = "24h"
+  action      = "blocked"
+  max_entries = 500
+}
+
+output "blocked_ips" {
+  value = distinct([for e in data.pangolin_request_logs.blocked_today.entries : e.ip])
+}
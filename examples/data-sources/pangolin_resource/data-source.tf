@@ -0,0 +1,13 @@
+data "pangolin_resource" "app" {
+  org_id      = "your-org-id"
+  full_domain = "app.example.com"
+}
+
+data "pangolin_resource" "by_nice_id" {
+  org_id  = "your-org-id"
+  nice_id = "quiet-fox"
+}
+
+output "app_resource_id" {
+  value = data.pangolin_resource.app.id
+}
@@ -0,0 +1,8 @@
+data "pangolin_resources" "public" {
+  org_id  = "my-org"
+  enabled = true
+}
+
+output "public_domains" {
+  value = [for r in data.pangolin_resources.public.resources : r.full_domain if r.http]
+}
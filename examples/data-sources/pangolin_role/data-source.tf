@@ -6,3 +6,13 @@ data "pangolin_role" "admin" {
 output "admin_role_id" {
   value = data.pangolin_role.admin.id
 }
+
+data "pangolin_role" "members" {
+  org_id      = "your-org-id"
+  name        = "member"
+  ignore_case = true
+}
+
+output "member_count" {
+  value = length(data.pangolin_role.members.member_user_ids)
+}
@@ -0,0 +1,14 @@
+data "pangolin_roles" "non_admin" {
+  org_id   = "your-org-id"
+  is_admin = false
+}
+
+# Grant every non-admin role access to a site resource.
+resource "pangolin_site_resource" "wiki" {
+  org_id      = "your-org-id"
+  name        = "Wiki"
+  mode        = "host"
+  site_id     = 1
+  destination = "wiki.internal"
+  role_ids    = data.pangolin_roles.non_admin.ids
+}
@@ -6,3 +6,16 @@ data "pangolin_site" "main" {
 output "site_id" {
   value = data.pangolin_site.main.id
 }
+
+# Gate a deploy on the site tunnel being up.
+data "pangolin_site" "edge" {
+  org_id  = "your-org-id"
+  nice_id = "lucky-owl"
+
+  lifecycle {
+    postcondition {
+      condition     = self.online
+      error_message = "Site ${self.name} is offline."
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data "pangolin_site" "home" {
+  org_id = "my-org"
+  name   = "home-lab"
+}
+
+data "pangolin_site_resources" "home" {
+  org_id     = "my-org"
+  site_id    = data.pangolin_site.home.id
+  name_regex = "(?i)db"
+}
@@ -0,0 +1,9 @@
+data "pangolin_sites" "offline" {
+  org_id     = "my-org"
+  name_regex = "^prod-"
+  online     = false
+}
+
+output "offline_prod_sites" {
+  value = [for s in data.pangolin_sites.offline.sites : s.name]
+}
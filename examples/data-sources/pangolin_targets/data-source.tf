@@ -0,0 +1,8 @@
+data "pangolin_targets" "web" {
+  resource_id = 42
+  enabled     = true
+}
+
+output "web_backends" {
+  value = [for t in data.pangolin_targets.web.targets : "${t.ip}:${t.port}"]
+}
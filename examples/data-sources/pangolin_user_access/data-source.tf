@@ -0,0 +1,15 @@
+data "pangolin_user_access" "contractor" {
+  org_id  = "your-org-id"
+  user_id = "contractor-user-id"
+}
+
+# Contractors must never reach production networks.
+check "contractor_isolation" {
+  assert {
+    condition = alltrue([
+      for r in data.pangolin_user_access.contractor.site_resources :
+      !(r.mode == "cidr" && startswith(r.destination, "10.10."))
+    ])
+    error_message = "The contractor can reach a production CIDR."
+  }
+}
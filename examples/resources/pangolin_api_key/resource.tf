@@ -0,0 +1,17 @@
+resource "pangolin_api_key" "ci" {
+  org_id = "your-org-id"
+  name   = "ci-pipeline"
+  actions = [
+    "listSites",
+    "getSite",
+    "createResource",
+    "updateResource",
+    "createTarget",
+    "updateTarget",
+  ]
+}
+
+output "ci_api_key" {
+  value     = pangolin_api_key.ci.key
+  sensitive = true
+}
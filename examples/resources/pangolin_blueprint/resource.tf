@@ -0,0 +1,32 @@
+# Apply an existing blueprint file.
+resource "pangolin_blueprint" "from_file" {
+  org_id  = "your-org-id"
+  content = file("${path.module}/blueprint.yaml")
+
+  # Applying a large blueprint can take longer than one API request may.
+  timeouts {
+    create = "10m"
+    update = "10m"
+  }
+}
+
+# Or describe the blueprint directly in HCL.
+resource "pangolin_blueprint" "from_hcl" {
+  org_id = "your-org-id"
+  config = {
+    proxy-resources = {
+      grafana = {
+        name        = "Grafana"
+        protocol    = "http"
+        full-domain = "grafana.your-domain.com"
+        targets = [
+          {
+            site     = "main-site"
+            hostname = "grafana"
+            port     = 3000
+          },
+        ]
+      }
+    }
+  }
+}
@@ -0,0 +1,18 @@
+variable "keycloak_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "pangolin_idp_oidc" "keycloak" {
+  name                  = "Keycloak"
+  client_id             = "pangolin"
+  client_secret         = var.keycloak_client_secret
+  client_secret_version = 1
+  auth_url              = "https://keycloak.your-domain.com/realms/main/protocol/openid-connect/auth"
+  token_url             = "https://keycloak.your-domain.com/realms/main/protocol/openid-connect/token"
+  identifier_path       = "sub"
+  email_path            = "email"
+  name_path             = "name"
+  scopes                = ["openid", "profile", "email"]
+  auto_provision        = true
+}
@@ -0,0 +1,18 @@
+resource "pangolin_role" "operators" {
+  org_id = "your-org-id"
+  name   = "Operators"
+}
+
+resource "pangolin_idp_org_policy" "example" {
+  idp_id = pangolin_idp_oidc.keycloak.id
+  org_id = "your-org-id"
+
+  role_rules = [
+    {
+      condition = "contains(groups, 'ops')"
+      role      = pangolin_role.operators.name
+    },
+  ]
+  default_role = "Member"
+  org_mapping  = "contains(groups, 'pangolin')"
+}
@@ -0,0 +1,12 @@
+resource "pangolin_invitation" "example" {
+  org_id      = "your-org-id"
+  email       = "new.hire@your-domain.com"
+  role_id     = 2
+  valid_hours = 72
+  send_email  = true
+}
+
+output "invite_link" {
+  value     = pangolin_invitation.example.invite_link
+  sensitive = true
+}
@@ -0,0 +1,8 @@
+resource "pangolin_org_user" "example" {
+  org_id   = "your-org-id"
+  idp_id   = 1
+  username = "jane.doe"
+  email    = "jane.doe@your-domain.com"
+  name     = "Jane Doe"
+  role_id  = 2
+}
@@ -0,0 +1,8 @@
+# By numeric ID
+terraform import pangolin_resource.app your-org-id/42
+
+# By nice ID
+terraform import pangolin_resource.app your-org-id/nice:quiet-fox
+
+# By full domain
+terraform import pangolin_resource.app your-org-id/host:app.example.com
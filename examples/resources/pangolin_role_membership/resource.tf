@@ -0,0 +1,10 @@
+resource "pangolin_role" "operators" {
+  org_id = "your-org-id"
+  name   = "Operators"
+}
+
+resource "pangolin_role_membership" "example" {
+  org_id  = "your-org-id"
+  role_id = pangolin_role.operators.id
+  user_id = "user-id"
+}
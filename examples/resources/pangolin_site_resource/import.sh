@@ -0,0 +1,11 @@
+# By numeric ID
+terraform import pangolin_site_resource.db your-org-id/7
+
+# By nice ID
+terraform import pangolin_site_resource.db your-org-id/nice:brave-otter
+
+# By nice ID within site 1, when the nice ID is not unique in the organization
+terraform import pangolin_site_resource.db your-org-id/1/nice:brave-otter
+
+# By destination host or alias
+terraform import pangolin_site_resource.db your-org-id/host:db.internal
@@ -0,0 +1,4 @@
+resource "pangolin_user_two_factor" "example" {
+  user_id  = "user-id"
+  required = true
+}
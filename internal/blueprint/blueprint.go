@@ -0,0 +1,301 @@
+// Package blueprint models Pangolin's declarative blueprint format and renders
+// it as JSON, YAML or docker labels.
+package blueprint
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LabelPrefix is the prefix newt expects on blueprint docker labels.
+const LabelPrefix = "pangolin"
+
+type Blueprint struct {
+	ProxyResources   map[string]ProxyResource   `json:"proxy-resources,omitempty" yaml:"proxy-resources,omitempty"`
+	PrivateResources map[string]PrivateResource `json:"private-resources,omitempty" yaml:"private-resources,omitempty"`
+}
+
+type ProxyResource struct {
+	Name          string   `json:"name" yaml:"name"`
+	Protocol      string   `json:"protocol" yaml:"protocol"`
+	FullDomain    string   `json:"full-domain,omitempty" yaml:"full-domain,omitempty"`
+	ProxyPort     int      `json:"proxy-port,omitempty" yaml:"proxy-port,omitempty"`
+	SSL           *bool    `json:"ssl,omitempty" yaml:"ssl,omitempty"`
+	Enabled       *bool    `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	HostHeader    string   `json:"host-header,omitempty" yaml:"host-header,omitempty"`
+	TLSServerName string   `json:"tls-server-name,omitempty" yaml:"tls-server-name,omitempty"`
+	Headers       []Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Auth          *Auth    `json:"auth,omitempty" yaml:"auth,omitempty"`
+	Rules         []Rule   `json:"rules,omitempty" yaml:"rules,omitempty"`
+	Targets       []Target `json:"targets,omitempty" yaml:"targets,omitempty"`
+}
+
+type Header struct {
+	Name  string `json:"name" yaml:"name"`
+	Value string `json:"value" yaml:"value"`
+}
+
+type Auth struct {
+	Pincode        string     `json:"pincode,omitempty" yaml:"pincode,omitempty"`
+	Password       string     `json:"password,omitempty" yaml:"password,omitempty"`
+	BasicAuth      *BasicAuth `json:"basic-auth,omitempty" yaml:"basic-auth,omitempty"`
+	SSOEnabled     *bool      `json:"sso-enabled,omitempty" yaml:"sso-enabled,omitempty"`
+	SSORoles       []string   `json:"sso-roles,omitempty" yaml:"sso-roles,omitempty"`
+	SSOUsers       []string   `json:"sso-users,omitempty" yaml:"sso-users,omitempty"`
+	WhitelistUsers []string   `json:"whitelist-users,omitempty" yaml:"whitelist-users,omitempty"`
+}
+
+type BasicAuth struct {
+	User     string `json:"user" yaml:"user"`
+	Password string `json:"password" yaml:"password"`
+}
+
+type Rule struct {
+	Action string `json:"action" yaml:"action"`
+	Match  string `json:"match" yaml:"match"`
+	Value  string `json:"value" yaml:"value"`
+}
+
+type Target struct {
+	Site         string `json:"site,omitempty" yaml:"site,omitempty"`
+	Hostname     string `json:"hostname" yaml:"hostname"`
+	Port         int    `json:"port" yaml:"port"`
+	Method       string `json:"method,omitempty" yaml:"method,omitempty"`
+	Enabled      *bool  `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Path         string `json:"path,omitempty" yaml:"path,omitempty"`
+	PathMatch    string `json:"path-match,omitempty" yaml:"path-match,omitempty"`
+	RewritePath  string `json:"rewrite-path,omitempty" yaml:"rewrite-path,omitempty"`
+	RewriteMatch string `json:"rewrite-match,omitempty" yaml:"rewrite-match,omitempty"`
+	Priority     *int   `json:"priority,omitempty" yaml:"priority,omitempty"`
+}
+
+type PrivateResource struct {
+	Name        string   `json:"name" yaml:"name"`
+	Mode        string   `json:"mode" yaml:"mode"`
+	Site        string   `json:"site" yaml:"site"`
+	Destination string   `json:"destination" yaml:"destination"`
+	Alias       string   `json:"alias,omitempty" yaml:"alias,omitempty"`
+	Enabled     *bool    `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	TCPPorts    string   `json:"tcp-ports,omitempty" yaml:"tcp-ports,omitempty"`
+	UDPPorts    string   `json:"udp-ports,omitempty" yaml:"udp-ports,omitempty"`
+	DisableICMP *bool    `json:"disable-icmp,omitempty" yaml:"disable-icmp,omitempty"`
+	Roles       []string `json:"roles,omitempty" yaml:"roles,omitempty"`
+	Users       []string `json:"users,omitempty" yaml:"users,omitempty"`
+}
+
+// Validate checks the blueprint against the rules Pangolin enforces when
+// applying it and returns all problems found.
+func (b *Blueprint) Validate() error {
+	var errs []error
+
+	for _, key := range sortedKeys(b.ProxyResources) {
+		for _, err := range b.ProxyResources[key].validate() {
+			errs = append(errs, fmt.Errorf("proxy-resources.%s: %w", key, err))
+		}
+	}
+	for _, key := range sortedKeys(b.PrivateResources) {
+		for _, err := range b.PrivateResources[key].validate() {
+			errs = append(errs, fmt.Errorf("private-resources.%s: %w", key, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r ProxyResource) validate() []error {
+	var errs []error
+
+	if r.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+
+	switch r.Protocol {
+	case "http":
+		if r.FullDomain == "" {
+			errs = append(errs, errors.New("full-domain is required for http resources"))
+		}
+		if r.ProxyPort != 0 {
+			errs = append(errs, errors.New("proxy-port is only valid for tcp and udp resources"))
+		}
+	case "tcp", "udp":
+		if !validPort(r.ProxyPort) {
+			errs = append(errs, fmt.Errorf("proxy-port must be between 1 and 65535 for %s resources", r.Protocol))
+		}
+		if r.FullDomain != "" {
+			errs = append(errs, errors.New("full-domain is only valid for http resources"))
+		}
+		if r.Auth != nil {
+			errs = append(errs, errors.New("auth is only valid for http resources"))
+		}
+		if len(r.Rules) > 0 {
+			errs = append(errs, errors.New("rules are only valid for http resources"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("protocol must be one of http, tcp or udp, got %q", r.Protocol))
+	}
+
+	for i, h := range r.Headers {
+		if h.Name == "" {
+			errs = append(errs, fmt.Errorf("headers[%d]: name is required", i))
+		}
+	}
+
+	if r.Auth != nil && r.Auth.BasicAuth != nil && (r.Auth.BasicAuth.User == "" || r.Auth.BasicAuth.Password == "") {
+		errs = append(errs, errors.New("auth.basic-auth: user and password are required"))
+	}
+
+	for i, rule := range r.Rules {
+		if !oneOf(rule.Action, "allow", "deny", "pass") {
+			errs = append(errs, fmt.Errorf("rules[%d]: action must be one of allow, deny or pass, got %q", i, rule.Action))
+		}
+		if !oneOf(rule.Match, "cidr", "ip", "path", "country") {
+			errs = append(errs, fmt.Errorf("rules[%d]: match must be one of cidr, ip, path or country, got %q", i, rule.Match))
+		}
+		if rule.Value == "" {
+			errs = append(errs, fmt.Errorf("rules[%d]: value is required", i))
+		}
+		if rule.Match == "cidr" {
+			if _, _, err := net.ParseCIDR(rule.Value); err != nil {
+				errs = append(errs, fmt.Errorf("rules[%d]: value %q is not a valid CIDR", i, rule.Value))
+			}
+		}
+		if rule.Match == "ip" && net.ParseIP(rule.Value) == nil {
+			errs = append(errs, fmt.Errorf("rules[%d]: value %q is not a valid IP address", i, rule.Value))
+		}
+	}
+
+	for i, t := range r.Targets {
+		if t.Hostname == "" {
+			errs = append(errs, fmt.Errorf("targets[%d]: hostname is required", i))
+		}
+		if !validPort(t.Port) {
+			errs = append(errs, fmt.Errorf("targets[%d]: port must be between 1 and 65535", i))
+		}
+		if t.Method != "" {
+			if r.Protocol != "http" {
+				errs = append(errs, fmt.Errorf("targets[%d]: method is only valid for http resources", i))
+			} else if !oneOf(t.Method, "http", "https", "h2c") {
+				errs = append(errs, fmt.Errorf("targets[%d]: method must be one of http, https or h2c, got %q", i, t.Method))
+			}
+		}
+		if t.PathMatch != "" && !oneOf(t.PathMatch, "exact", "prefix", "regex") {
+			errs = append(errs, fmt.Errorf("targets[%d]: path-match must be one of exact, prefix or regex, got %q", i, t.PathMatch))
+		}
+		if t.RewriteMatch != "" && !oneOf(t.RewriteMatch, "exact", "prefix", "regex", "stripPrefix") {
+			errs = append(errs, fmt.Errorf("targets[%d]: rewrite-match must be one of exact, prefix, regex or stripPrefix, got %q", i, t.RewriteMatch))
+		}
+	}
+
+	return errs
+}
+
+func (r PrivateResource) validate() []error {
+	var errs []error
+
+	if r.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	if r.Site == "" {
+		errs = append(errs, errors.New("site is required"))
+	}
+
+	switch r.Mode {
+	case "host":
+		if r.Destination == "" {
+			errs = append(errs, errors.New("destination is required"))
+		}
+	case "cidr":
+		if _, _, err := net.ParseCIDR(r.Destination); err != nil {
+			errs = append(errs, fmt.Errorf("destination %q is not a valid CIDR", r.Destination))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("mode must be one of host or cidr, got %q", r.Mode))
+	}
+
+	return errs
+}
+
+// JSON renders the blueprint as compact JSON.
+func (b *Blueprint) JSON() ([]byte, error) {
+	return json.Marshal(b)
+}
+
+// YAML renders the blueprint as YAML.
+func (b *Blueprint) YAML() ([]byte, error) {
+	return yaml.Marshal(b)
+}
+
+// Base64 renders the blueprint as base64 encoded JSON, the form accepted by
+// the blueprint API.
+func (b *Blueprint) Base64() (string, error) {
+	data, err := b.JSON()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// Labels flattens the blueprint into docker labels, e.g.
+// pangolin.proxy-resources.web.targets[0].port=8080.
+func (b *Blueprint) Labels() (map[string]string, error) {
+	data, err := b.JSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	labels := make(map[string]string)
+	flatten(LabelPrefix, doc, labels)
+	return labels, nil
+}
+
+func flatten(prefix string, v interface{}, out map[string]string) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			flatten(prefix+"."+k, item, out)
+		}
+	case []interface{}:
+		for i, item := range val {
+			flatten(fmt.Sprintf("%s[%d]", prefix, i), item, out)
+		}
+	case string:
+		out[prefix] = val
+	case nil:
+	default:
+		out[prefix] = strings.TrimSpace(fmt.Sprint(val))
+	}
+}
+
+func validPort(p int) bool {
+	return p >= 1 && p <= 65535
+}
+
+func oneOf(v string, allowed ...string) bool {
+	for _, a := range allowed {
+		if v == a {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,173 @@
+package blueprint
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func boolPtr(b bool) *bool { return &b }
+
+func testBlueprint() *Blueprint {
+	return &Blueprint{
+		ProxyResources: map[string]ProxyResource{
+			"web": {
+				Name:       "Web",
+				Protocol:   "http",
+				FullDomain: "web.example.com",
+				SSL:        boolPtr(true),
+				Auth: &Auth{
+					SSOEnabled: boolPtr(true),
+					SSORoles:   []string{"Member"},
+				},
+				Rules: []Rule{
+					{Action: "allow", Match: "cidr", Value: "10.0.0.0/8"},
+				},
+				Targets: []Target{
+					{Site: "main", Hostname: "web", Port: 8080, Method: "http"},
+				},
+			},
+		},
+		PrivateResources: map[string]PrivateResource{
+			"db": {
+				Name:        "Database",
+				Mode:        "host",
+				Site:        "main",
+				Destination: "db.internal",
+				TCPPorts:    "5432",
+			},
+		},
+	}
+}
+
+func TestValidate_Valid(t *testing.T) {
+	if err := testBlueprint().Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidate_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Blueprint)
+		want   string
+	}{
+		{
+			name: "http without domain",
+			modify: func(b *Blueprint) {
+				r := b.ProxyResources["web"]
+				r.FullDomain = ""
+				b.ProxyResources["web"] = r
+			},
+			want: "proxy-resources.web: full-domain is required",
+		},
+		{
+			name: "tcp without port",
+			modify: func(b *Blueprint) {
+				b.ProxyResources["ssh"] = ProxyResource{Name: "SSH", Protocol: "tcp", Targets: []Target{{Hostname: "h", Port: 22}}}
+			},
+			want: "proxy-resources.ssh: proxy-port must be between 1 and 65535",
+		},
+		{
+			name: "auth on tcp",
+			modify: func(b *Blueprint) {
+				b.ProxyResources["ssh"] = ProxyResource{Name: "SSH", Protocol: "tcp", ProxyPort: 2222, Auth: &Auth{}}
+			},
+			want: "auth is only valid for http resources",
+		},
+		{
+			name: "bad rule cidr",
+			modify: func(b *Blueprint) {
+				r := b.ProxyResources["web"]
+				r.Rules = []Rule{{Action: "deny", Match: "cidr", Value: "nope"}}
+				b.ProxyResources["web"] = r
+			},
+			want: `rules[0]: value "nope" is not a valid CIDR`,
+		},
+		{
+			name: "bad target port",
+			modify: func(b *Blueprint) {
+				r := b.ProxyResources["web"]
+				r.Targets = []Target{{Hostname: "web", Port: 0}}
+				b.ProxyResources["web"] = r
+			},
+			want: "targets[0]: port must be between 1 and 65535",
+		},
+		{
+			name: "private cidr mode",
+			modify: func(b *Blueprint) {
+				r := b.PrivateResources["db"]
+				r.Mode = "cidr"
+				b.PrivateResources["db"] = r
+			},
+			want: `private-resources.db: destination "db.internal" is not a valid CIDR`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := testBlueprint()
+			tt.modify(b)
+			err := b.Validate()
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %q", tt.want, err.Error())
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	b := testBlueprint()
+
+	jsonDoc, err := b.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(jsonDoc), `{"proxy-resources":{"web":{"name":"Web","protocol":"http","full-domain":"web.example.com"`) {
+		t.Errorf("unexpected JSON: %s", jsonDoc)
+	}
+
+	yamlDoc, err := b.YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(yamlDoc), "private-resources:\n    db:\n        name: Database\n") {
+		t.Errorf("unexpected YAML:\n%s", yamlDoc)
+	}
+
+	encoded, err := b.Base64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(decoded) != string(jsonDoc) {
+		t.Errorf("base64 does not decode to the JSON document")
+	}
+}
+
+func TestLabels(t *testing.T) {
+	labels, err := testBlueprint().Labels()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"pangolin.proxy-resources.web.full-domain":       "web.example.com",
+		"pangolin.proxy-resources.web.ssl":               "true",
+		"pangolin.proxy-resources.web.auth.sso-roles[0]": "Member",
+		"pangolin.proxy-resources.web.targets[0].port":   "8080",
+		"pangolin.proxy-resources.web.rules[0].value":    "10.0.0.0/8",
+		"pangolin.private-resources.db.tcp-ports":        "5432",
+	}
+	for k, v := range want {
+		if labels[k] != v {
+			t.Errorf("label %s = %q, want %q", k, labels[k], v)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package client
+
+// ActionIDs lists the permission action IDs that can be granted to an
+// organization API key. It mirrors Pangolin's ActionsEnum for the actions that
+// are reachable through the Integration API.
+var ActionIDs = []string{
+	// Organizations
+	"getOrg",
+	"updateOrg",
+	"deleteOrg",
+	"checkOrgId",
+
+	// Sites
+	"createSite",
+	"deleteSite",
+	"getSite",
+	"listSites",
+	"updateSite",
+	"createNewt",
+
+	// Site resources
+	"createSiteResource",
+	"deleteSiteResource",
+	"getSiteResource",
+	"listSiteResources",
+	"updateSiteResource",
+
+	// Resources
+	"createResource",
+	"deleteResource",
+	"getResource",
+	"listResources",
+	"updateResource",
+	"listResourceRoles",
+	"setResourceRoles",
+	"listResourceUsers",
+	"setResourceUsers",
+	"setResourcePassword",
+	"setResourcePincode",
+	"setResourceHeaderAuth",
+	"setResourceWhitelist",
+	"getResourceWhitelist",
+
+	// Resource rules
+	"createResourceRule",
+	"deleteResourceRule",
+	"listResourceRules",
+	"updateResourceRule",
+
+	// Targets
+	"createTarget",
+	"deleteTarget",
+	"getTarget",
+	"listTargets",
+	"updateTarget",
+
+	// Roles
+	"createRole",
+	"deleteRole",
+	"getRole",
+	"listRoles",
+	"updateRole",
+	"listRoleResources",
+	"addUserRole",
+
+	// Users and invitations
+	"createOrgUser",
+	"getOrgUser",
+	"updateOrgUser",
+	"listUsers",
+	"removeUser",
+	"getUser",
+	"updateUser",
+	"inviteUser",
+	"listInvitations",
+	"removeInvitation",
+
+	// Clients
+	"createClient",
+	"deleteClient",
+	"updateClient",
+	"listClients",
+	"getClient",
+
+	// Access tokens
+	"generateAccessToken",
+	"deleteAcessToken",
+	"listAccessTokens",
+
+	// Domains
+	"listOrgDomains",
+	"getDomain",
+	"updateOrgDomain",
+	"getDNSRecords",
+
+	// Identity providers
+	"createIdp",
+	"updateIdp",
+	"deleteIdp",
+	"listIdps",
+	"getIdp",
+	"createIdpOrg",
+	"deleteIdpOrg",
+	"listIdpOrgs",
+	"updateIdpOrg",
+
+	// API keys
+	"createApiKey",
+	"deleteApiKey",
+	"setApiKeyActions",
+	"listApiKeyActions",
+	"listApiKeys",
+	"getApiKey",
+
+	// Blueprints
+	"applyBlueprint",
+	"listBlueprints",
+	"getBlueprint",
+
+	// Logs
+	"viewLogs",
+	"exportLogs",
+}
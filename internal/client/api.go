@@ -0,0 +1,48 @@
+package client
+
+import "encoding/json"
+
+//go:generate go run ../openapi/cmd/openapi-gen -spec ../../tests/env/config/openapi.yaml -out api_gen.go
+
+// API exposes every operation of the Integration API, generated from the
+// bundled OpenAPI document into api_gen.go. Requests go through doRequest, so
+// authentication and envelope handling are shared with the Client methods.
+// Operations return the data of the response envelope.
+type API struct {
+	client *Client
+}
+
+// API returns the generated operations bound to the client.
+func (c *Client) API() *API {
+	return &API{client: c}
+}
+
+// bodyOf keeps a nil request body nil once it is passed as an interface.
+func bodyOf[T any](body *T) interface{} {
+	if body == nil {
+		return nil
+	}
+	return body
+}
+
+// decode unmarshals response data into a new T.
+func decode[T any](data json.RawMessage, err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	var out T
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func ptr[T any](v T) *T { return &v }
+
+// optional returns nil for the empty string, so the field is left out.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,1842 @@
+// Code generated by openapi-gen from tests/env/config/openapi.yaml. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// ClientFilter is accepted by GET /org/{orgId}/clients.
+type ClientFilter string
+
+const (
+	ClientFilterUser    ClientFilter = "user"
+	ClientFilterMachine ClientFilter = "machine"
+)
+
+// ClientFilterValues lists the values of ClientFilter.
+func ClientFilterValues() []string {
+	return []string{"user", "machine"}
+}
+
+// ClientType is accepted by PUT /org/{orgId}/client, PUT /org/{orgId}/user/{userId}/client.
+type ClientType string
+
+const (
+	ClientTypeOLM ClientType = "olm"
+)
+
+// ClientTypeValues lists the values of ClientType.
+func ClientTypeValues() []string {
+	return []string{"olm"}
+}
+
+// RequestMethod is accepted by GET /org/{orgId}/logs/request.
+type RequestMethod string
+
+const (
+	RequestMethodGet    RequestMethod = "GET"
+	RequestMethodPost   RequestMethod = "POST"
+	RequestMethodPut    RequestMethod = "PUT"
+	RequestMethodDelete RequestMethod = "DELETE"
+	RequestMethodPatch  RequestMethod = "PATCH"
+)
+
+// RequestMethodValues lists the values of RequestMethod.
+func RequestMethodValues() []string {
+	return []string{"GET", "POST", "PUT", "DELETE", "PATCH"}
+}
+
+// ResourceMaintenanceModeType is accepted by POST /resource/{resourceId}.
+type ResourceMaintenanceModeType string
+
+const (
+	ResourceMaintenanceModeTypeForced    ResourceMaintenanceModeType = "forced"
+	ResourceMaintenanceModeTypeAutomatic ResourceMaintenanceModeType = "automatic"
+)
+
+// ResourceMaintenanceModeTypeValues lists the values of ResourceMaintenanceModeType.
+func ResourceMaintenanceModeTypeValues() []string {
+	return []string{"forced", "automatic"}
+}
+
+// ResourceProtocol is accepted by PUT /org/{orgId}/resource.
+type ResourceProtocol string
+
+const (
+	ResourceProtocolTCP ResourceProtocol = "tcp"
+	ResourceProtocolUDP ResourceProtocol = "udp"
+)
+
+// ResourceProtocolValues lists the values of ResourceProtocol.
+func ResourceProtocolValues() []string {
+	return []string{"tcp", "udp"}
+}
+
+// RuleAction is accepted by PUT /resource/{resourceId}/rule, POST /resource/{resourceId}/rule/{ruleId}.
+type RuleAction string
+
+const (
+	RuleActionAccept RuleAction = "ACCEPT"
+	RuleActionDrop   RuleAction = "DROP"
+	RuleActionPass   RuleAction = "PASS"
+)
+
+// RuleActionValues lists the values of RuleAction.
+func RuleActionValues() []string {
+	return []string{"ACCEPT", "DROP", "PASS"}
+}
+
+// RuleMatch is accepted by PUT /resource/{resourceId}/rule, POST /resource/{resourceId}/rule/{ruleId}.
+type RuleMatch string
+
+const (
+	RuleMatchCIDR    RuleMatch = "CIDR"
+	RuleMatchIP      RuleMatch = "IP"
+	RuleMatchPath    RuleMatch = "PATH"
+	RuleMatchCountry RuleMatch = "COUNTRY"
+	RuleMatchASN     RuleMatch = "ASN"
+)
+
+// RuleMatchValues lists the values of RuleMatch.
+func RuleMatchValues() []string {
+	return []string{"CIDR", "IP", "PATH", "COUNTRY", "ASN"}
+}
+
+// SiteResourceMode is accepted by PUT /org/{orgId}/site-resource, POST /site-resource/{siteResourceId}.
+type SiteResourceMode string
+
+const (
+	SiteResourceModeHost SiteResourceMode = "host"
+	SiteResourceModeCIDR SiteResourceMode = "cidr"
+	SiteResourceModePort SiteResourceMode = "port"
+)
+
+// SiteResourceModeValues lists the values of SiteResourceMode.
+func SiteResourceModeValues() []string {
+	return []string{"host", "cidr", "port"}
+}
+
+// SiteType is accepted by PUT /org/{orgId}/site.
+type SiteType string
+
+const (
+	SiteTypeNewt      SiteType = "newt"
+	SiteTypeWireGuard SiteType = "wireguard"
+	SiteTypeLocal     SiteType = "local"
+)
+
+// SiteTypeValues lists the values of SiteType.
+func SiteTypeValues() []string {
+	return []string{"newt", "wireguard", "local"}
+}
+
+// TargetPathMatchType is accepted by PUT /resource/{resourceId}/target, POST /target/{targetId}.
+type TargetPathMatchType string
+
+const (
+	TargetPathMatchTypeExact  TargetPathMatchType = "exact"
+	TargetPathMatchTypePrefix TargetPathMatchType = "prefix"
+	TargetPathMatchTypeRegex  TargetPathMatchType = "regex"
+)
+
+// TargetPathMatchTypeValues lists the values of TargetPathMatchType.
+func TargetPathMatchTypeValues() []string {
+	return []string{"exact", "prefix", "regex"}
+}
+
+// TargetRewritePathType is accepted by PUT /resource/{resourceId}/target, POST /target/{targetId}.
+type TargetRewritePathType string
+
+const (
+	TargetRewritePathTypeExact       TargetRewritePathType = "exact"
+	TargetRewritePathTypePrefix      TargetRewritePathType = "prefix"
+	TargetRewritePathTypeRegex       TargetRewritePathType = "regex"
+	TargetRewritePathTypeStripPrefix TargetRewritePathType = "stripPrefix"
+)
+
+// TargetRewritePathTypeValues lists the values of TargetRewritePathType.
+func TargetRewritePathTypeValues() []string {
+	return []string{"exact", "prefix", "regex", "stripPrefix"}
+}
+
+// UserType is accepted by PUT /org/{orgId}/user.
+type UserType string
+
+const (
+	UserTypeInternal UserType = "internal"
+	UserTypeOIDC     UserType = "oidc"
+)
+
+// UserTypeValues lists the values of UserType.
+func UserTypeValues() []string {
+	return []string{"internal", "oidc"}
+}
+
+// GetRoot calls GET /: Health check
+func (a *API) GetRoot(ctx context.Context) (json.RawMessage, error) {
+	path := "/"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// DeleteAccessToken calls DELETE /access-token/{accessTokenId}: Delete a access token.
+func (a *API) DeleteAccessToken(ctx context.Context, accessTokenID string) (json.RawMessage, error) {
+	path := "/access-token/" + url.PathEscape(accessTokenID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// GetClient calls GET /client/{clientId}: Get a client by its client ID.
+func (a *API) GetClient(ctx context.Context, clientID int) (json.RawMessage, error) {
+	path := "/client/" + strconv.Itoa(clientID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostClient calls POST /client/{clientId}: Update a client by its client ID.
+func (a *API) PostClient(ctx context.Context, clientID int, body *PostClientBody) (json.RawMessage, error) {
+	path := "/client/" + strconv.Itoa(clientID)
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostClientBody is the request body of POST /client/{clientId}.
+type PostClientBody struct {
+	Name   *string `json:"name,omitempty"`
+	NiceID *string `json:"niceId,omitempty"`
+}
+
+// DeleteClient calls DELETE /client/{clientId}: Delete a client by its client ID.
+func (a *API) DeleteClient(ctx context.Context, clientID int) (json.RawMessage, error) {
+	path := "/client/" + strconv.Itoa(clientID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// PostClientArchive calls POST /client/{clientId}/archive: Archive a client by its client ID.
+func (a *API) PostClientArchive(ctx context.Context, clientID int) (json.RawMessage, error) {
+	path := "/client/" + strconv.Itoa(clientID) + "/archive"
+	return a.client.doRequest(ctx, "POST", path, nil)
+}
+
+// PostClientBlock calls POST /client/{clientId}/block: Block a client by its client ID.
+func (a *API) PostClientBlock(ctx context.Context, clientID int) (json.RawMessage, error) {
+	path := "/client/" + strconv.Itoa(clientID) + "/block"
+	return a.client.doRequest(ctx, "POST", path, nil)
+}
+
+// PostClientUnarchive calls POST /client/{clientId}/unarchive: Unarchive a client by its client ID.
+func (a *API) PostClientUnarchive(ctx context.Context, clientID int) (json.RawMessage, error) {
+	path := "/client/" + strconv.Itoa(clientID) + "/unarchive"
+	return a.client.doRequest(ctx, "POST", path, nil)
+}
+
+// PostClientUnblock calls POST /client/{clientId}/unblock: Unblock a client by its client ID.
+func (a *API) PostClientUnblock(ctx context.Context, clientID int) (json.RawMessage, error) {
+	path := "/client/" + strconv.Itoa(clientID) + "/unblock"
+	return a.client.doRequest(ctx, "POST", path, nil)
+}
+
+// GetIdp calls GET /idp: List all IDP in the system.
+func (a *API) GetIdp(ctx context.Context, query *GetIdpQuery) (json.RawMessage, error) {
+	path := "/idp"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetIdpQuery holds the query parameters of GET /idp. Empty fields are not sent.
+type GetIdpQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetIdpQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// PutIdpOIDC calls PUT /idp/oidc: Create an OIDC IdP.
+func (a *API) PutIdpOIDC(ctx context.Context, body *PutIdpOIDCBody) (json.RawMessage, error) {
+	path := "/idp/oidc"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutIdpOIDCBody is the request body of PUT /idp/oidc.
+type PutIdpOIDCBody struct {
+	AuthURL        string  `json:"authUrl"`
+	AutoProvision  *bool   `json:"autoProvision,omitempty"`
+	ClientID       string  `json:"clientId"`
+	ClientSecret   string  `json:"clientSecret"`
+	EmailPath      *string `json:"emailPath,omitempty"`
+	IdentifierPath string  `json:"identifierPath"`
+	Name           string  `json:"name"`
+	NamePath       *string `json:"namePath,omitempty"`
+	Scopes         string  `json:"scopes"`
+	Tags           *string `json:"tags,omitempty"`
+	TokenURL       string  `json:"tokenUrl"`
+}
+
+// GetIdpByIdpID calls GET /idp/{idpId}: Get an IDP by its IDP ID.
+func (a *API) GetIdpByIdpID(ctx context.Context, idpID int) (json.RawMessage, error) {
+	path := "/idp/" + strconv.Itoa(idpID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// DeleteIdp calls DELETE /idp/{idpId}: Delete IDP.
+func (a *API) DeleteIdp(ctx context.Context, idpID int) (json.RawMessage, error) {
+	path := "/idp/" + strconv.Itoa(idpID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// PostIdpOIDC calls POST /idp/{idpId}/oidc: Update an OIDC IdP.
+func (a *API) PostIdpOIDC(ctx context.Context, idpID int, body *PostIdpOIDCBody) (json.RawMessage, error) {
+	path := "/idp/" + strconv.Itoa(idpID) + "/oidc"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostIdpOIDCBody is the request body of POST /idp/{idpId}/oidc.
+type PostIdpOIDCBody struct {
+	AuthURL            *string `json:"authUrl,omitempty"`
+	AutoProvision      *bool   `json:"autoProvision,omitempty"`
+	ClientID           *string `json:"clientId,omitempty"`
+	ClientSecret       *string `json:"clientSecret,omitempty"`
+	DefaultOrgMapping  *string `json:"defaultOrgMapping,omitempty"`
+	DefaultRoleMapping *string `json:"defaultRoleMapping,omitempty"`
+	EmailPath          *string `json:"emailPath,omitempty"`
+	IdentifierPath     *string `json:"identifierPath,omitempty"`
+	Name               *string `json:"name,omitempty"`
+	NamePath           *string `json:"namePath,omitempty"`
+	Scopes             *string `json:"scopes,omitempty"`
+	Tags               *string `json:"tags,omitempty"`
+	TokenURL           *string `json:"tokenUrl,omitempty"`
+}
+
+// GetIdpOrg calls GET /idp/{idpId}/org: List all org policies on an IDP.
+func (a *API) GetIdpOrg(ctx context.Context, idpID int, query *GetIdpOrgQuery) (json.RawMessage, error) {
+	path := "/idp/" + strconv.Itoa(idpID) + "/org"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetIdpOrgQuery holds the query parameters of GET /idp/{idpId}/org. Empty fields are not sent.
+type GetIdpOrgQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetIdpOrgQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// PutIdpOrg calls PUT /idp/{idpId}/org/{orgId}: Create an IDP policy for an existing IDP on an organization.
+func (a *API) PutIdpOrg(ctx context.Context, idpID int, orgID string, body *PutIdpOrgBody) (json.RawMessage, error) {
+	path := "/idp/" + strconv.Itoa(idpID) + "/org/" + url.PathEscape(orgID)
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutIdpOrgBody is the request body of PUT /idp/{idpId}/org/{orgId}.
+type PutIdpOrgBody struct {
+	OrgMapping  *string `json:"orgMapping,omitempty"`
+	RoleMapping *string `json:"roleMapping,omitempty"`
+}
+
+// PostIdpOrg calls POST /idp/{idpId}/org/{orgId}: Update an IDP org policy.
+func (a *API) PostIdpOrg(ctx context.Context, idpID int, orgID string, body *PostIdpOrgBody) (json.RawMessage, error) {
+	path := "/idp/" + strconv.Itoa(idpID) + "/org/" + url.PathEscape(orgID)
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostIdpOrgBody is the request body of POST /idp/{idpId}/org/{orgId}.
+type PostIdpOrgBody struct {
+	OrgMapping  *string `json:"orgMapping,omitempty"`
+	RoleMapping *string `json:"roleMapping,omitempty"`
+}
+
+// DeleteIdpOrg calls DELETE /idp/{idpId}/org/{orgId}: Create an OIDC IdP for an organization.
+func (a *API) DeleteIdpOrg(ctx context.Context, idpID int, orgID string) (json.RawMessage, error) {
+	path := "/idp/" + strconv.Itoa(idpID) + "/org/" + url.PathEscape(orgID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// PutOrg calls PUT /org: Create a new organization
+func (a *API) PutOrg(ctx context.Context, body *PutOrgBody) (json.RawMessage, error) {
+	path := "/org"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutOrgBody is the request body of PUT /org.
+type PutOrgBody struct {
+	Name          string `json:"name"`
+	OrgID         string `json:"orgId"`
+	Subnet        string `json:"subnet"`
+	UtilitySubnet string `json:"utilitySubnet"`
+}
+
+// GetOrg calls GET /org/{orgId}: Get an organization
+func (a *API) GetOrg(ctx context.Context, orgID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostOrg calls POST /org/{orgId}: Update an organization
+func (a *API) PostOrg(ctx context.Context, orgID string, body *PostOrgBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID)
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostOrgBody is the request body of POST /org/{orgId}.
+type PostOrgBody struct {
+	MaxSessionLengthHours           *float64 `json:"maxSessionLengthHours,omitempty"`
+	Name                            *string  `json:"name,omitempty"`
+	PasswordExpiryDays              *float64 `json:"passwordExpiryDays,omitempty"`
+	RequireTwoFactor                *bool    `json:"requireTwoFactor,omitempty"`
+	SettingsLogRetentionDaysAccess  *float64 `json:"settingsLogRetentionDaysAccess,omitempty"`
+	SettingsLogRetentionDaysAction  *float64 `json:"settingsLogRetentionDaysAction,omitempty"`
+	SettingsLogRetentionDaysRequest *float64 `json:"settingsLogRetentionDaysRequest,omitempty"`
+}
+
+// DeleteOrg calls DELETE /org/{orgId}: Delete an organization
+func (a *API) DeleteOrg(ctx context.Context, orgID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// GetOrgAccessTokens calls GET /org/{orgId}/access-tokens: List all access tokens in an organization.
+func (a *API) GetOrgAccessTokens(ctx context.Context, orgID string, query *GetOrgAccessTokensQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/access-tokens"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgAccessTokensQuery holds the query parameters of GET /org/{orgId}/access-tokens. Empty fields are not sent.
+type GetOrgAccessTokensQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgAccessTokensQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// PutOrgAPIKey calls PUT /org/{orgId}/api-key: Create a new API key scoped to the organization.
+func (a *API) PutOrgAPIKey(ctx context.Context, orgID string, body *PutOrgAPIKeyBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/api-key"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutOrgAPIKeyBody is the request body of PUT /org/{orgId}/api-key.
+type PutOrgAPIKeyBody struct {
+	Name string `json:"name"`
+}
+
+// DeleteOrgAPIKey calls DELETE /org/{orgId}/api-key/{apiKeyId}: Delete an API key.
+func (a *API) DeleteOrgAPIKey(ctx context.Context, orgID string, apiKeyID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/api-key/" + url.PathEscape(apiKeyID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// GetOrgAPIKeyActions calls GET /org/{orgId}/api-key/{apiKeyId}/actions: List all actions set for an API key.
+func (a *API) GetOrgAPIKeyActions(ctx context.Context, orgID string, apiKeyID string, query *GetOrgAPIKeyActionsQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/api-key/" + url.PathEscape(apiKeyID) + "/actions"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgAPIKeyActionsQuery holds the query parameters of GET /org/{orgId}/api-key/{apiKeyId}/actions. Empty fields are not sent.
+type GetOrgAPIKeyActionsQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgAPIKeyActionsQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// PostOrgAPIKeyActions calls POST /org/{orgId}/api-key/{apiKeyId}/actions: Set actions for an API key. This will replace any existing actions.
+func (a *API) PostOrgAPIKeyActions(ctx context.Context, orgID string, apiKeyID string, body *PostOrgAPIKeyActionsBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/api-key/" + url.PathEscape(apiKeyID) + "/actions"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostOrgAPIKeyActionsBody is the request body of POST /org/{orgId}/api-key/{apiKeyId}/actions.
+type PostOrgAPIKeyActionsBody struct {
+	ActionIDs []string `json:"actionIds"`
+}
+
+// GetOrgAPIKeys calls GET /org/{orgId}/api-keys: List all API keys for an organization
+func (a *API) GetOrgAPIKeys(ctx context.Context, orgID string, query *GetOrgAPIKeysQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/api-keys"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgAPIKeysQuery holds the query parameters of GET /org/{orgId}/api-keys. Empty fields are not sent.
+type GetOrgAPIKeysQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgAPIKeysQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// PutOrgBlueprint calls PUT /org/{orgId}/blueprint: Apply a base64 encoded JSON blueprint to an organization
+func (a *API) PutOrgBlueprint(ctx context.Context, orgID string, body *PutOrgBlueprintBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/blueprint"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutOrgBlueprintBody is the request body of PUT /org/{orgId}/blueprint.
+type PutOrgBlueprintBody struct {
+	Blueprint string `json:"blueprint"`
+}
+
+// GetOrgBlueprint calls GET /org/{orgId}/blueprint/{blueprintId}: Get a blueprint by its blueprint ID.
+func (a *API) GetOrgBlueprint(ctx context.Context, orgID string, blueprintID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/blueprint/" + url.PathEscape(blueprintID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgBlueprints calls GET /org/{orgId}/blueprints: List all blueprints for a organization.
+func (a *API) GetOrgBlueprints(ctx context.Context, orgID string, query *GetOrgBlueprintsQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/blueprints"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgBlueprintsQuery holds the query parameters of GET /org/{orgId}/blueprints. Empty fields are not sent.
+type GetOrgBlueprintsQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgBlueprintsQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// PutOrgClient calls PUT /org/{orgId}/client: Create a new client for an organization.
+func (a *API) PutOrgClient(ctx context.Context, orgID string, body *PutOrgClientBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/client"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutOrgClientBody is the request body of PUT /org/{orgId}/client.
+type PutOrgClientBody struct {
+	Name   string     `json:"name"`
+	OLMID  string     `json:"olmId"`
+	Secret string     `json:"secret"`
+	Subnet string     `json:"subnet"`
+	Type   ClientType `json:"type"`
+}
+
+// GetOrgClient calls GET /org/{orgId}/client/{niceId}: Get a client by orgId and niceId. NiceId is a readable ID for the site and unique on a per org basis.
+func (a *API) GetOrgClient(ctx context.Context, orgID string, niceID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/client/" + url.PathEscape(niceID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgClients calls GET /org/{orgId}/clients: List all clients for an organization.
+func (a *API) GetOrgClients(ctx context.Context, orgID string, query *GetOrgClientsQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/clients"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgClientsQuery holds the query parameters of GET /org/{orgId}/clients. Empty fields are not sent.
+type GetOrgClientsQuery struct {
+	Limit  string
+	Offset string
+	Filter ClientFilter
+}
+
+func (q *GetOrgClientsQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	if q.Filter != "" {
+		v.Set("filter", string(q.Filter))
+	}
+	return v.Encode()
+}
+
+// PostOrgCreateInvite calls POST /org/{orgId}/create-invite: Invite a user to join an organization.
+func (a *API) PostOrgCreateInvite(ctx context.Context, orgID string, body *PostOrgCreateInviteBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/create-invite"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostOrgCreateInviteBody is the request body of POST /org/{orgId}/create-invite.
+type PostOrgCreateInviteBody struct {
+	Email      string  `json:"email"`
+	Regenerate *bool   `json:"regenerate,omitempty"`
+	RoleID     float64 `json:"roleId"`
+	SendEmail  *bool   `json:"sendEmail,omitempty"`
+	ValidHours float64 `json:"validHours"`
+}
+
+// GetOrgDomain calls GET /org/{orgId}/domain/{domainId}: Get a domain by domainId.
+func (a *API) GetOrgDomain(ctx context.Context, orgID string, domainID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/domain/" + url.PathEscape(domainID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PatchOrgDomain calls PATCH /org/{orgId}/domain/{domainId}: Update a domain by domainId.
+func (a *API) PatchOrgDomain(ctx context.Context, orgID string, domainID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/domain/" + url.PathEscape(domainID)
+	return a.client.doRequest(ctx, "PATCH", path, nil)
+}
+
+// GetOrgDomainDNSRecords calls GET /org/{orgId}/domain/{domainId}/dns-records: Get all DNS records for a domain by domainId.
+func (a *API) GetOrgDomainDNSRecords(ctx context.Context, orgID string, domainID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/domain/" + url.PathEscape(domainID) + "/dns-records"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgDomains calls GET /org/{orgId}/domains: List all domains for a organization.
+func (a *API) GetOrgDomains(ctx context.Context, orgID string, query *GetOrgDomainsQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/domains"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgDomainsQuery holds the query parameters of GET /org/{orgId}/domains. Empty fields are not sent.
+type GetOrgDomainsQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgDomainsQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// GetOrgInvitations calls GET /org/{orgId}/invitations: List invitations in an organization.
+func (a *API) GetOrgInvitations(ctx context.Context, orgID string, query *GetOrgInvitationsQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/invitations"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgInvitationsQuery holds the query parameters of GET /org/{orgId}/invitations. Empty fields are not sent.
+type GetOrgInvitationsQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgInvitationsQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// DeleteOrgInvitations calls DELETE /org/{orgId}/invitations/{inviteId}: Remove an open invitation from an organization
+func (a *API) DeleteOrgInvitations(ctx context.Context, orgID string, inviteID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/invitations/" + url.PathEscape(inviteID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// GetOrgLogsAnalytics calls GET /org/{orgId}/logs/analytics: Query the request audit analytics for an organization
+func (a *API) GetOrgLogsAnalytics(ctx context.Context, orgID string, query *GetOrgLogsAnalyticsQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/logs/analytics"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgLogsAnalyticsQuery holds the query parameters of GET /org/{orgId}/logs/analytics. Empty fields are not sent.
+type GetOrgLogsAnalyticsQuery struct {
+	// Start time as ISO date string (defaults to 7 days ago)
+	TimeStart string
+	// End time as ISO date string (defaults to current time)
+	TimeEnd    string
+	ResourceID string
+}
+
+func (q *GetOrgLogsAnalyticsQuery) encode() string {
+	v := url.Values{}
+	if q.TimeStart != "" {
+		v.Set("timeStart", q.TimeStart)
+	}
+	if q.TimeEnd != "" {
+		v.Set("timeEnd", q.TimeEnd)
+	}
+	if q.ResourceID != "" {
+		v.Set("resourceId", q.ResourceID)
+	}
+	return v.Encode()
+}
+
+// GetOrgLogsRequest calls GET /org/{orgId}/logs/request: Query the request audit log for an organization
+func (a *API) GetOrgLogsRequest(ctx context.Context, orgID string, query *GetOrgLogsRequestQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/logs/request"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgLogsRequestQuery holds the query parameters of GET /org/{orgId}/logs/request. Empty fields are not sent.
+type GetOrgLogsRequestQuery struct {
+	// Start time as ISO date string (defaults to 7 days ago)
+	TimeStart string
+	// End time as ISO date string (defaults to current time)
+	TimeEnd    string
+	Action     string
+	Method     RequestMethod
+	Reason     string
+	ResourceID string
+	Actor      string
+	Location   string
+	Host       string
+	Path       string
+}
+
+func (q *GetOrgLogsRequestQuery) encode() string {
+	v := url.Values{}
+	if q.TimeStart != "" {
+		v.Set("timeStart", q.TimeStart)
+	}
+	if q.TimeEnd != "" {
+		v.Set("timeEnd", q.TimeEnd)
+	}
+	if q.Action != "" {
+		v.Set("action", q.Action)
+	}
+	if q.Method != "" {
+		v.Set("method", string(q.Method))
+	}
+	if q.Reason != "" {
+		v.Set("reason", q.Reason)
+	}
+	if q.ResourceID != "" {
+		v.Set("resourceId", q.ResourceID)
+	}
+	if q.Actor != "" {
+		v.Set("actor", q.Actor)
+	}
+	if q.Location != "" {
+		v.Set("location", q.Location)
+	}
+	if q.Host != "" {
+		v.Set("host", q.Host)
+	}
+	if q.Path != "" {
+		v.Set("path", q.Path)
+	}
+	return v.Encode()
+}
+
+// GetOrgPickClientDefaults calls GET /org/{orgId}/pick-client-defaults: Return pre-requisite data for creating a client.
+func (a *API) GetOrgPickClientDefaults(ctx context.Context, orgID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/pick-client-defaults"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgPickSiteDefaults calls GET /org/{orgId}/pick-site-defaults: Return pre-requisite data for creating a site, such as the exit node, subnet, Newt credentials, etc.
+func (a *API) GetOrgPickSiteDefaults(ctx context.Context, orgID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/pick-site-defaults"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PutOrgResource calls PUT /org/{orgId}/resource: Create a resource.
+func (a *API) PutOrgResource(ctx context.Context, orgID string, body *PutOrgResourceBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/resource"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutOrgResourceBody is the request body of PUT /org/{orgId}/resource.
+type PutOrgResourceBody struct {
+	DomainID      *string          `json:"domainId,omitempty"`
+	HTTP          bool             `json:"http"`
+	Name          string           `json:"name"`
+	Protocol      ResourceProtocol `json:"protocol"`
+	ProxyPort     *int             `json:"proxyPort,omitempty"`
+	StickySession *bool            `json:"stickySession,omitempty"`
+	Subdomain     *string          `json:"subdomain,omitempty"`
+}
+
+// GetOrgResource calls GET /org/{orgId}/resource/{niceId}: Get a resource by orgId and niceId. NiceId is a readable ID for the resource and unique on a per org basis.
+func (a *API) GetOrgResource(ctx context.Context, orgID string, niceID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/resource/" + url.PathEscape(niceID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgResources calls GET /org/{orgId}/resources: List resources for an organization.
+func (a *API) GetOrgResources(ctx context.Context, orgID string, query *GetOrgResourcesQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/resources"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgResourcesQuery holds the query parameters of GET /org/{orgId}/resources. Empty fields are not sent.
+type GetOrgResourcesQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgResourcesQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// GetOrgResourcesNames calls GET /org/{orgId}/resources-names: List all resource names for an organization.
+func (a *API) GetOrgResourcesNames(ctx context.Context, orgID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/resources-names"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PutOrgRole calls PUT /org/{orgId}/role: Create a role.
+func (a *API) PutOrgRole(ctx context.Context, orgID string, body *PutOrgRoleBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/role"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutOrgRoleBody is the request body of PUT /org/{orgId}/role.
+type PutOrgRoleBody struct {
+	Description           *string `json:"description,omitempty"`
+	Name                  string  `json:"name"`
+	RequireDeviceApproval *bool   `json:"requireDeviceApproval,omitempty"`
+}
+
+// GetOrgRoles calls GET /org/{orgId}/roles: List roles.
+func (a *API) GetOrgRoles(ctx context.Context, orgID string, query *GetOrgRolesQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/roles"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgRolesQuery holds the query parameters of GET /org/{orgId}/roles. Empty fields are not sent.
+type GetOrgRolesQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgRolesQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// PutOrgSite calls PUT /org/{orgId}/site: Create a new site.
+func (a *API) PutOrgSite(ctx context.Context, orgID string, body *PutOrgSiteBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/site"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutOrgSiteBody is the request body of PUT /org/{orgId}/site.
+type PutOrgSiteBody struct {
+	Address    *string  `json:"address,omitempty"`
+	ExitNodeID *int     `json:"exitNodeId,omitempty"`
+	Name       string   `json:"name"`
+	NewtID     *string  `json:"newtId,omitempty"`
+	PubKey     *string  `json:"pubKey,omitempty"`
+	Secret     *string  `json:"secret,omitempty"`
+	Subnet     *string  `json:"subnet,omitempty"`
+	Type       SiteType `json:"type"`
+}
+
+// PutOrgSiteResource calls PUT /org/{orgId}/site-resource: Create a new site resource.
+func (a *API) PutOrgSiteResource(ctx context.Context, orgID string, body *PutOrgSiteResourceBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/site-resource"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutOrgSiteResourceBody is the request body of PUT /org/{orgId}/site-resource.
+type PutOrgSiteResourceBody struct {
+	Alias              *string          `json:"alias,omitempty"`
+	ClientIDs          []int            `json:"clientIds"`
+	Destination        string           `json:"destination"`
+	DisableICMP        *bool            `json:"disableIcmp,omitempty"`
+	Enabled            *bool            `json:"enabled,omitempty"`
+	Mode               SiteResourceMode `json:"mode"`
+	Name               string           `json:"name"`
+	RoleIDs            []int            `json:"roleIds"`
+	SiteID             int              `json:"siteId"`
+	TCPPortRangeString *string          `json:"tcpPortRangeString,omitempty"`
+	UDPPortRangeString *string          `json:"udpPortRangeString,omitempty"`
+	UserIDs            []string         `json:"userIds"`
+}
+
+// GetOrgSiteResourcesByOrgID calls GET /org/{orgId}/site-resources: List all site resources for an organization.
+func (a *API) GetOrgSiteResourcesByOrgID(ctx context.Context, orgID string, query *GetOrgSiteResourcesByOrgIDQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/site-resources"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgSiteResourcesByOrgIDQuery holds the query parameters of GET /org/{orgId}/site-resources. Empty fields are not sent.
+type GetOrgSiteResourcesByOrgIDQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgSiteResourcesByOrgIDQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// GetOrgSite calls GET /org/{orgId}/site/{niceId}: Get a site by orgId and niceId. NiceId is a readable ID for the site and unique on a per org basis.
+func (a *API) GetOrgSite(ctx context.Context, orgID string, niceID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/site/" + url.PathEscape(niceID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgSiteResourceNice calls GET /org/{orgId}/site/{siteId}/resource/nice/{niceId}: Get a specific site resource by niceId.
+func (a *API) GetOrgSiteResourceNice(ctx context.Context, orgID string, siteID int, niceID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/site/" + strconv.Itoa(siteID) + "/resource/nice/" + url.PathEscape(niceID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgSiteResourcesBySiteID calls GET /org/{orgId}/site/{siteId}/resources: List site resources for a site.
+func (a *API) GetOrgSiteResourcesBySiteID(ctx context.Context, orgID string, siteID int, query *GetOrgSiteResourcesBySiteIDQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/site/" + strconv.Itoa(siteID) + "/resources"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgSiteResourcesBySiteIDQuery holds the query parameters of GET /org/{orgId}/site/{siteId}/resources. Empty fields are not sent.
+type GetOrgSiteResourcesBySiteIDQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgSiteResourcesBySiteIDQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// GetOrgSites calls GET /org/{orgId}/sites: List all sites in an organization
+func (a *API) GetOrgSites(ctx context.Context, orgID string, query *GetOrgSitesQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/sites"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgSitesQuery holds the query parameters of GET /org/{orgId}/sites. Empty fields are not sent.
+type GetOrgSitesQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgSitesQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// PutOrgUser calls PUT /org/{orgId}/user: Create an organization user.
+func (a *API) PutOrgUser(ctx context.Context, orgID string, body *PutOrgUserBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/user"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutOrgUserBody is the request body of PUT /org/{orgId}/user.
+type PutOrgUserBody struct {
+	Email    *string   `json:"email,omitempty"`
+	IdpID    *float64  `json:"idpId,omitempty"`
+	Name     *string   `json:"name,omitempty"`
+	RoleID   float64   `json:"roleId"`
+	Type     *UserType `json:"type,omitempty"`
+	Username string    `json:"username"`
+}
+
+// GetOrgUser calls GET /org/{orgId}/user/{userId}: Get a user in an organization.
+func (a *API) GetOrgUser(ctx context.Context, orgID string, userID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/user/" + url.PathEscape(userID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostOrgUser calls POST /org/{orgId}/user/{userId}: Update a user in an org.
+func (a *API) PostOrgUser(ctx context.Context, orgID string, userID string, body *PostOrgUserBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/user/" + url.PathEscape(userID)
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostOrgUserBody is the request body of POST /org/{orgId}/user/{userId}.
+type PostOrgUserBody struct {
+	AutoProvisioned *bool `json:"autoProvisioned,omitempty"`
+}
+
+// DeleteOrgUser calls DELETE /org/{orgId}/user/{userId}: Remove a user from an organization.
+func (a *API) DeleteOrgUser(ctx context.Context, orgID string, userID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/user/" + url.PathEscape(userID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// GetOrgUserCheck calls GET /org/{orgId}/user/{userId}/check: Check a user's access in an organization.
+func (a *API) GetOrgUserCheck(ctx context.Context, orgID string, userID string) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/user/" + url.PathEscape(userID) + "/check"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PutOrgUserClient calls PUT /org/{orgId}/user/{userId}/client: Create a new client for a user and associate it with an existing olm.
+func (a *API) PutOrgUserClient(ctx context.Context, orgID string, userID string, body *PutOrgUserClientBody) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/user/" + url.PathEscape(userID) + "/client"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutOrgUserClientBody is the request body of PUT /org/{orgId}/user/{userId}/client.
+type PutOrgUserClientBody struct {
+	Name   string     `json:"name"`
+	OLMID  string     `json:"olmId"`
+	Subnet string     `json:"subnet"`
+	Type   ClientType `json:"type"`
+}
+
+// GetOrgUsers calls GET /org/{orgId}/users: List users in an organization.
+func (a *API) GetOrgUsers(ctx context.Context, orgID string, query *GetOrgUsersQuery) (json.RawMessage, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/users"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgUsersQuery holds the query parameters of GET /org/{orgId}/users. Empty fields are not sent.
+type GetOrgUsersQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgUsersQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// GetOrgs calls GET /orgs: List all organizations in the system.
+func (a *API) GetOrgs(ctx context.Context, query *GetOrgsQuery) (json.RawMessage, error) {
+	path := "/orgs"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetOrgsQuery holds the query parameters of GET /orgs. Empty fields are not sent.
+type GetOrgsQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetOrgsQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// GetResource calls GET /resource/{resourceId}: Get a resource by resourceId.
+func (a *API) GetResource(ctx context.Context, resourceID int) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostResource calls POST /resource/{resourceId}: Update a resource.
+func (a *API) PostResource(ctx context.Context, resourceID int, body *PostResourceBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID)
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceBody is the request body of POST /resource/{resourceId}.
+type PostResourceBody struct {
+	ApplyRules               *bool                        `json:"applyRules,omitempty"`
+	BlockAccess              *bool                        `json:"blockAccess,omitempty"`
+	DomainID                 *string                      `json:"domainId,omitempty"`
+	EmailWhitelistEnabled    *bool                        `json:"emailWhitelistEnabled,omitempty"`
+	Enabled                  *bool                        `json:"enabled,omitempty"`
+	Headers                  []PostResourceHeader         `json:"headers,omitempty"`
+	MaintenanceEstimatedTime *string                      `json:"maintenanceEstimatedTime,omitempty"`
+	MaintenanceMessage       *string                      `json:"maintenanceMessage,omitempty"`
+	MaintenanceModeEnabled   *bool                        `json:"maintenanceModeEnabled,omitempty"`
+	MaintenanceModeType      *ResourceMaintenanceModeType `json:"maintenanceModeType,omitempty"`
+	MaintenanceTitle         *string                      `json:"maintenanceTitle,omitempty"`
+	Name                     *string                      `json:"name,omitempty"`
+	NiceID                   *string                      `json:"niceId,omitempty"`
+	ProxyPort                *int                         `json:"proxyPort,omitempty"`
+	ProxyProtocol            *bool                        `json:"proxyProtocol,omitempty"`
+	ProxyProtocolVersion     *int                         `json:"proxyProtocolVersion,omitempty"`
+	SetHostHeader            *string                      `json:"setHostHeader,omitempty"`
+	SkipToIdpID              *int                         `json:"skipToIdpId,omitempty"`
+	SSL                      *bool                        `json:"ssl,omitempty"`
+	SSO                      *bool                        `json:"sso,omitempty"`
+	StickySession            *bool                        `json:"stickySession,omitempty"`
+	Subdomain                *string                      `json:"subdomain,omitempty"`
+	TLSServerName            *string                      `json:"tlsServerName,omitempty"`
+}
+
+// PostResourceHeader is the headers element of PostResource.
+type PostResourceHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// DeleteResource calls DELETE /resource/{resourceId}: Delete a resource.
+func (a *API) DeleteResource(ctx context.Context, resourceID int) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// PostResourceAccessToken calls POST /resource/{resourceId}/access-token: Generate a new access token for a resource.
+func (a *API) PostResourceAccessToken(ctx context.Context, resourceID int, body *PostResourceAccessTokenBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/access-token"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceAccessTokenBody is the request body of POST /resource/{resourceId}/access-token.
+type PostResourceAccessTokenBody struct {
+	Description     *string `json:"description,omitempty"`
+	Title           *string `json:"title,omitempty"`
+	ValidForSeconds *int    `json:"validForSeconds,omitempty"`
+}
+
+// GetResourceAccessTokens calls GET /resource/{resourceId}/access-tokens: List all access tokens in an organization.
+func (a *API) GetResourceAccessTokens(ctx context.Context, resourceID int, query *GetResourceAccessTokensQuery) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/access-tokens"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetResourceAccessTokensQuery holds the query parameters of GET /resource/{resourceId}/access-tokens. Empty fields are not sent.
+type GetResourceAccessTokensQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetResourceAccessTokensQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// PostResourceHeaderAuth calls POST /resource/{resourceId}/header-auth: Set or update the header authentication for a resource. If user and password is not provided, it will remove the header authentication.
+func (a *API) PostResourceHeaderAuth(ctx context.Context, resourceID int, body *PostResourceHeaderAuthBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/header-auth"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceHeaderAuthBody is the request body of POST /resource/{resourceId}/header-auth.
+type PostResourceHeaderAuthBody struct {
+	ExtendedCompatibility *bool   `json:"extendedCompatibility"`
+	Password              *string `json:"password"`
+	User                  *string `json:"user"`
+}
+
+// PostResourcePassword calls POST /resource/{resourceId}/password: Set the password for a resource. Setting the password to null will remove it.
+func (a *API) PostResourcePassword(ctx context.Context, resourceID int, body *PostResourcePasswordBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/password"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourcePasswordBody is the request body of POST /resource/{resourceId}/password.
+type PostResourcePasswordBody struct {
+	Password *string `json:"password"`
+}
+
+// PostResourcePincode calls POST /resource/{resourceId}/pincode: Set the PIN code for a resource. Setting the PIN code to null will remove it.
+func (a *API) PostResourcePincode(ctx context.Context, resourceID int, body *PostResourcePincodeBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/pincode"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourcePincodeBody is the request body of POST /resource/{resourceId}/pincode.
+type PostResourcePincodeBody struct {
+	Pincode *string `json:"pincode"`
+}
+
+// GetResourceRoles calls GET /resource/{resourceId}/roles: List all roles for a resource.
+func (a *API) GetResourceRoles(ctx context.Context, resourceID int) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/roles"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostResourceRoles calls POST /resource/{resourceId}/roles: Set roles for a resource. This will replace all existing roles.
+func (a *API) PostResourceRoles(ctx context.Context, resourceID int, body *PostResourceRolesBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/roles"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceRolesBody is the request body of POST /resource/{resourceId}/roles.
+type PostResourceRolesBody struct {
+	RoleIDs []int `json:"roleIds"`
+}
+
+// PostResourceRolesAdd calls POST /resource/{resourceId}/roles/add: Add a single role to a resource.
+func (a *API) PostResourceRolesAdd(ctx context.Context, resourceID int, body *PostResourceRolesAddBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/roles/add"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceRolesAddBody is the request body of POST /resource/{resourceId}/roles/add.
+type PostResourceRolesAddBody struct {
+	RoleID int `json:"roleId"`
+}
+
+// PostResourceRolesRemove calls POST /resource/{resourceId}/roles/remove: Remove a single role from a resource.
+func (a *API) PostResourceRolesRemove(ctx context.Context, resourceID int, body *PostResourceRolesRemoveBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/roles/remove"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceRolesRemoveBody is the request body of POST /resource/{resourceId}/roles/remove.
+type PostResourceRolesRemoveBody struct {
+	RoleID int `json:"roleId"`
+}
+
+// PutResourceRule calls PUT /resource/{resourceId}/rule: Create a resource rule.
+func (a *API) PutResourceRule(ctx context.Context, resourceID int, body *PutResourceRuleBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/rule"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutResourceRuleBody is the request body of PUT /resource/{resourceId}/rule.
+type PutResourceRuleBody struct {
+	Action   RuleAction `json:"action"`
+	Enabled  *bool      `json:"enabled,omitempty"`
+	Match    RuleMatch  `json:"match"`
+	Priority int        `json:"priority"`
+	Value    string     `json:"value"`
+}
+
+// PostResourceRule calls POST /resource/{resourceId}/rule/{ruleId}: Update a resource rule.
+func (a *API) PostResourceRule(ctx context.Context, resourceID int, ruleID string, body *PostResourceRuleBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/rule/" + url.PathEscape(ruleID)
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceRuleBody is the request body of POST /resource/{resourceId}/rule/{ruleId}.
+type PostResourceRuleBody struct {
+	Action   *RuleAction `json:"action,omitempty"`
+	Enabled  *bool       `json:"enabled,omitempty"`
+	Match    *RuleMatch  `json:"match,omitempty"`
+	Priority int         `json:"priority"`
+	Value    *string     `json:"value,omitempty"`
+}
+
+// DeleteResourceRule calls DELETE /resource/{resourceId}/rule/{ruleId}: Delete a resource rule.
+func (a *API) DeleteResourceRule(ctx context.Context, resourceID int, ruleID string) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/rule/" + url.PathEscape(ruleID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// GetResourceRules calls GET /resource/{resourceId}/rules: List rules for a resource.
+func (a *API) GetResourceRules(ctx context.Context, resourceID int, query *GetResourceRulesQuery) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/rules"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetResourceRulesQuery holds the query parameters of GET /resource/{resourceId}/rules. Empty fields are not sent.
+type GetResourceRulesQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetResourceRulesQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// PutResourceTarget calls PUT /resource/{resourceId}/target: Create a target for a resource.
+func (a *API) PutResourceTarget(ctx context.Context, resourceID int, body *PutResourceTargetBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/target"
+	return a.client.doRequest(ctx, "PUT", path, bodyOf(body))
+}
+
+// PutResourceTargetBody is the request body of PUT /resource/{resourceId}/target.
+type PutResourceTargetBody struct {
+	Enabled             *bool                       `json:"enabled,omitempty"`
+	HCEnabled           *bool                       `json:"hcEnabled,omitempty"`
+	HCFollowRedirects   *bool                       `json:"hcFollowRedirects,omitempty"`
+	HCHeaders           []PutResourceTargetHCHeader `json:"hcHeaders,omitempty"`
+	HCHostname          *string                     `json:"hcHostname,omitempty"`
+	HCInterval          *int                        `json:"hcInterval,omitempty"`
+	HCMethod            *string                     `json:"hcMethod,omitempty"`
+	HCMode              *string                     `json:"hcMode,omitempty"`
+	HCPath              *string                     `json:"hcPath,omitempty"`
+	HCPort              *int                        `json:"hcPort,omitempty"`
+	HCScheme            *string                     `json:"hcScheme,omitempty"`
+	HCStatus            *int                        `json:"hcStatus,omitempty"`
+	HCTimeout           *int                        `json:"hcTimeout,omitempty"`
+	HCTLSServerName     *string                     `json:"hcTlsServerName,omitempty"`
+	HCUnhealthyInterval *int                        `json:"hcUnhealthyInterval,omitempty"`
+	IP                  string                      `json:"ip"`
+	Method              *string                     `json:"method,omitempty"`
+	Path                *string                     `json:"path,omitempty"`
+	PathMatchType       *TargetPathMatchType        `json:"pathMatchType,omitempty"`
+	Port                int                         `json:"port"`
+	Priority            *int                        `json:"priority,omitempty"`
+	RewritePath         *string                     `json:"rewritePath,omitempty"`
+	RewritePathType     *TargetRewritePathType      `json:"rewritePathType,omitempty"`
+	SiteID              int                         `json:"siteId"`
+}
+
+// PutResourceTargetHCHeader is the hcHeaders element of PutResourceTarget.
+type PutResourceTargetHCHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// GetResourceTargets calls GET /resource/{resourceId}/targets: List targets for a resource.
+func (a *API) GetResourceTargets(ctx context.Context, resourceID int, query *GetResourceTargetsQuery) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/targets"
+	if query != nil {
+		if q := query.encode(); q != "" {
+			path += "?" + q
+		}
+	}
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// GetResourceTargetsQuery holds the query parameters of GET /resource/{resourceId}/targets. Empty fields are not sent.
+type GetResourceTargetsQuery struct {
+	Limit  string
+	Offset string
+}
+
+func (q *GetResourceTargetsQuery) encode() string {
+	v := url.Values{}
+	if q.Limit != "" {
+		v.Set("limit", q.Limit)
+	}
+	if q.Offset != "" {
+		v.Set("offset", q.Offset)
+	}
+	return v.Encode()
+}
+
+// GetResourceUsers calls GET /resource/{resourceId}/users: List all users for a resource.
+func (a *API) GetResourceUsers(ctx context.Context, resourceID int) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/users"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostResourceUsers calls POST /resource/{resourceId}/users: Set users for a resource. This will replace all existing users.
+func (a *API) PostResourceUsers(ctx context.Context, resourceID int, body *PostResourceUsersBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/users"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceUsersBody is the request body of POST /resource/{resourceId}/users.
+type PostResourceUsersBody struct {
+	UserIDs []string `json:"userIds"`
+}
+
+// PostResourceUsersAdd calls POST /resource/{resourceId}/users/add: Add a single user to a resource.
+func (a *API) PostResourceUsersAdd(ctx context.Context, resourceID int, body *PostResourceUsersAddBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/users/add"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceUsersAddBody is the request body of POST /resource/{resourceId}/users/add.
+type PostResourceUsersAddBody struct {
+	UserID string `json:"userId"`
+}
+
+// PostResourceUsersRemove calls POST /resource/{resourceId}/users/remove: Remove a single user from a resource.
+func (a *API) PostResourceUsersRemove(ctx context.Context, resourceID int, body *PostResourceUsersRemoveBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/users/remove"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceUsersRemoveBody is the request body of POST /resource/{resourceId}/users/remove.
+type PostResourceUsersRemoveBody struct {
+	UserID string `json:"userId"`
+}
+
+// GetResourceWhitelist calls GET /resource/{resourceId}/whitelist: Get the whitelist of emails for a specific resource.
+func (a *API) GetResourceWhitelist(ctx context.Context, resourceID int) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/whitelist"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostResourceWhitelist calls POST /resource/{resourceId}/whitelist: Set email whitelist for a resource. This will replace all existing emails.
+func (a *API) PostResourceWhitelist(ctx context.Context, resourceID int, body *PostResourceWhitelistBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/whitelist"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceWhitelistBody is the request body of POST /resource/{resourceId}/whitelist.
+type PostResourceWhitelistBody struct {
+	Emails []string `json:"emails"`
+}
+
+// PostResourceWhitelistAdd calls POST /resource/{resourceId}/whitelist/add: Add a single email to the resource whitelist.
+func (a *API) PostResourceWhitelistAdd(ctx context.Context, resourceID int, body *PostResourceWhitelistAddBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/whitelist/add"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceWhitelistAddBody is the request body of POST /resource/{resourceId}/whitelist/add.
+type PostResourceWhitelistAddBody struct {
+	Email string `json:"email"`
+}
+
+// PostResourceWhitelistRemove calls POST /resource/{resourceId}/whitelist/remove: Remove a single email from the resource whitelist.
+func (a *API) PostResourceWhitelistRemove(ctx context.Context, resourceID int, body *PostResourceWhitelistRemoveBody) (json.RawMessage, error) {
+	path := "/resource/" + strconv.Itoa(resourceID) + "/whitelist/remove"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostResourceWhitelistRemoveBody is the request body of POST /resource/{resourceId}/whitelist/remove.
+type PostResourceWhitelistRemoveBody struct {
+	Email string `json:"email"`
+}
+
+// GetRole calls GET /role/{roleId}: Get a role.
+func (a *API) GetRole(ctx context.Context, roleID string) (json.RawMessage, error) {
+	path := "/role/" + url.PathEscape(roleID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostRole calls POST /role/{roleId}: Update a role.
+func (a *API) PostRole(ctx context.Context, roleID string, body *PostRoleBody) (json.RawMessage, error) {
+	path := "/role/" + url.PathEscape(roleID)
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostRoleBody is the request body of POST /role/{roleId}.
+type PostRoleBody struct {
+	Description           *string `json:"description,omitempty"`
+	Name                  *string `json:"name,omitempty"`
+	RequireDeviceApproval *bool   `json:"requireDeviceApproval,omitempty"`
+}
+
+// DeleteRole calls DELETE /role/{roleId}: Delete a role.
+func (a *API) DeleteRole(ctx context.Context, roleID string, body *DeleteRoleBody) (json.RawMessage, error) {
+	path := "/role/" + url.PathEscape(roleID)
+	return a.client.doRequest(ctx, "DELETE", path, bodyOf(body))
+}
+
+// DeleteRoleBody is the request body of DELETE /role/{roleId}.
+type DeleteRoleBody struct {
+	RoleID string `json:"roleId"`
+}
+
+// PostRoleAdd calls POST /role/{roleId}/add/{userId}: Add a role to a user.
+func (a *API) PostRoleAdd(ctx context.Context, roleID string, userID string) (json.RawMessage, error) {
+	path := "/role/" + url.PathEscape(roleID) + "/add/" + url.PathEscape(userID)
+	return a.client.doRequest(ctx, "POST", path, nil)
+}
+
+// GetSiteResource calls GET /site-resource/{siteResourceId}: Get a specific site resource by siteResourceId.
+func (a *API) GetSiteResource(ctx context.Context, siteResourceID int) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostSiteResource calls POST /site-resource/{siteResourceId}: Update a site resource.
+func (a *API) PostSiteResource(ctx context.Context, siteResourceID int, body *PostSiteResourceBody) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID)
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteResourceBody is the request body of POST /site-resource/{siteResourceId}.
+type PostSiteResourceBody struct {
+	Alias              *string           `json:"alias,omitempty"`
+	ClientIDs          []int             `json:"clientIds"`
+	Destination        *string           `json:"destination,omitempty"`
+	DisableICMP        *bool             `json:"disableIcmp,omitempty"`
+	Enabled            *bool             `json:"enabled,omitempty"`
+	Mode               *SiteResourceMode `json:"mode,omitempty"`
+	Name               *string           `json:"name,omitempty"`
+	RoleIDs            []int             `json:"roleIds"`
+	SiteID             int               `json:"siteId"`
+	TCPPortRangeString *string           `json:"tcpPortRangeString,omitempty"`
+	UDPPortRangeString *string           `json:"udpPortRangeString,omitempty"`
+	UserIDs            []string          `json:"userIds"`
+}
+
+// DeleteSiteResource calls DELETE /site-resource/{siteResourceId}: Delete a site resource.
+func (a *API) DeleteSiteResource(ctx context.Context, siteResourceID int) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// GetSiteResourceClients calls GET /site-resource/{siteResourceId}/clients: List all clients for a site resource.
+func (a *API) GetSiteResourceClients(ctx context.Context, siteResourceID int) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/clients"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostSiteResourceClients calls POST /site-resource/{siteResourceId}/clients: Set clients for a site resource. This will replace all existing clients. Clients with a userId cannot be added.
+func (a *API) PostSiteResourceClients(ctx context.Context, siteResourceID int, body *PostSiteResourceClientsBody) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/clients"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteResourceClientsBody is the request body of POST /site-resource/{siteResourceId}/clients.
+type PostSiteResourceClientsBody struct {
+	ClientIDs []int `json:"clientIds"`
+}
+
+// PostSiteResourceClientsAdd calls POST /site-resource/{siteResourceId}/clients/add: Add a single client to a site resource. Clients with a userId cannot be added.
+func (a *API) PostSiteResourceClientsAdd(ctx context.Context, siteResourceID int, body *PostSiteResourceClientsAddBody) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/clients/add"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteResourceClientsAddBody is the request body of POST /site-resource/{siteResourceId}/clients/add.
+type PostSiteResourceClientsAddBody struct {
+	ClientID int `json:"clientId"`
+}
+
+// PostSiteResourceClientsRemove calls POST /site-resource/{siteResourceId}/clients/remove: Remove a single client from a site resource. Clients with a userId cannot be removed.
+func (a *API) PostSiteResourceClientsRemove(ctx context.Context, siteResourceID int, body *PostSiteResourceClientsRemoveBody) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/clients/remove"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteResourceClientsRemoveBody is the request body of POST /site-resource/{siteResourceId}/clients/remove.
+type PostSiteResourceClientsRemoveBody struct {
+	ClientID int `json:"clientId"`
+}
+
+// GetSiteResourceRoles calls GET /site-resource/{siteResourceId}/roles: List all roles for a site resource.
+func (a *API) GetSiteResourceRoles(ctx context.Context, siteResourceID int) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/roles"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostSiteResourceRoles calls POST /site-resource/{siteResourceId}/roles: Set roles for a site resource. This will replace all existing roles.
+func (a *API) PostSiteResourceRoles(ctx context.Context, siteResourceID int, body *PostSiteResourceRolesBody) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/roles"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteResourceRolesBody is the request body of POST /site-resource/{siteResourceId}/roles.
+type PostSiteResourceRolesBody struct {
+	RoleIDs []int `json:"roleIds"`
+}
+
+// PostSiteResourceRolesAdd calls POST /site-resource/{siteResourceId}/roles/add: Add a single role to a site resource.
+func (a *API) PostSiteResourceRolesAdd(ctx context.Context, siteResourceID int, body *PostSiteResourceRolesAddBody) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/roles/add"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteResourceRolesAddBody is the request body of POST /site-resource/{siteResourceId}/roles/add.
+type PostSiteResourceRolesAddBody struct {
+	RoleID int `json:"roleId"`
+}
+
+// PostSiteResourceRolesRemove calls POST /site-resource/{siteResourceId}/roles/remove: Remove a single role from a site resource.
+func (a *API) PostSiteResourceRolesRemove(ctx context.Context, siteResourceID int, body *PostSiteResourceRolesRemoveBody) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/roles/remove"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteResourceRolesRemoveBody is the request body of POST /site-resource/{siteResourceId}/roles/remove.
+type PostSiteResourceRolesRemoveBody struct {
+	RoleID int `json:"roleId"`
+}
+
+// GetSiteResourceUsers calls GET /site-resource/{siteResourceId}/users: List all users for a site resource.
+func (a *API) GetSiteResourceUsers(ctx context.Context, siteResourceID int) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/users"
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostSiteResourceUsers calls POST /site-resource/{siteResourceId}/users: Set users for a site resource. This will replace all existing users.
+func (a *API) PostSiteResourceUsers(ctx context.Context, siteResourceID int, body *PostSiteResourceUsersBody) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/users"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteResourceUsersBody is the request body of POST /site-resource/{siteResourceId}/users.
+type PostSiteResourceUsersBody struct {
+	UserIDs []string `json:"userIds"`
+}
+
+// PostSiteResourceUsersAdd calls POST /site-resource/{siteResourceId}/users/add: Add a single user to a site resource.
+func (a *API) PostSiteResourceUsersAdd(ctx context.Context, siteResourceID int, body *PostSiteResourceUsersAddBody) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/users/add"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteResourceUsersAddBody is the request body of POST /site-resource/{siteResourceId}/users/add.
+type PostSiteResourceUsersAddBody struct {
+	UserID string `json:"userId"`
+}
+
+// PostSiteResourceUsersRemove calls POST /site-resource/{siteResourceId}/users/remove: Remove a single user from a site resource.
+func (a *API) PostSiteResourceUsersRemove(ctx context.Context, siteResourceID int, body *PostSiteResourceUsersRemoveBody) (json.RawMessage, error) {
+	path := "/site-resource/" + strconv.Itoa(siteResourceID) + "/users/remove"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteResourceUsersRemoveBody is the request body of POST /site-resource/{siteResourceId}/users/remove.
+type PostSiteResourceUsersRemoveBody struct {
+	UserID string `json:"userId"`
+}
+
+// GetSite calls GET /site/{siteId}: Get a site by siteId.
+func (a *API) GetSite(ctx context.Context, siteID int) (json.RawMessage, error) {
+	path := "/site/" + strconv.Itoa(siteID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostSite calls POST /site/{siteId}: Update a site.
+func (a *API) PostSite(ctx context.Context, siteID int, body *PostSiteBody) (json.RawMessage, error) {
+	path := "/site/" + strconv.Itoa(siteID)
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostSiteBody is the request body of POST /site/{siteId}.
+type PostSiteBody struct {
+	DockerSocketEnabled *bool   `json:"dockerSocketEnabled,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	NiceID              *string `json:"niceId,omitempty"`
+	RemoteSubnets       *string `json:"remoteSubnets,omitempty"`
+}
+
+// DeleteSite calls DELETE /site/{siteId}: Delete a site and all its associated data.
+func (a *API) DeleteSite(ctx context.Context, siteID int) (json.RawMessage, error) {
+	path := "/site/" + strconv.Itoa(siteID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// GetTarget calls GET /target/{targetId}: Get a target.
+func (a *API) GetTarget(ctx context.Context, targetID string) (json.RawMessage, error) {
+	path := "/target/" + url.PathEscape(targetID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostTarget calls POST /target/{targetId}: Update a target.
+func (a *API) PostTarget(ctx context.Context, targetID string, body *PostTargetBody) (json.RawMessage, error) {
+	path := "/target/" + url.PathEscape(targetID)
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostTargetBody is the request body of POST /target/{targetId}.
+type PostTargetBody struct {
+	Enabled             *bool                  `json:"enabled,omitempty"`
+	HCEnabled           *bool                  `json:"hcEnabled,omitempty"`
+	HCFollowRedirects   *bool                  `json:"hcFollowRedirects,omitempty"`
+	HCHeaders           []PostTargetHCHeader   `json:"hcHeaders,omitempty"`
+	HCHostname          *string                `json:"hcHostname,omitempty"`
+	HCInterval          *int                   `json:"hcInterval,omitempty"`
+	HCMethod            *string                `json:"hcMethod,omitempty"`
+	HCMode              *string                `json:"hcMode,omitempty"`
+	HCPath              *string                `json:"hcPath,omitempty"`
+	HCPort              *int                   `json:"hcPort,omitempty"`
+	HCScheme            *string                `json:"hcScheme,omitempty"`
+	HCStatus            *int                   `json:"hcStatus,omitempty"`
+	HCTimeout           *int                   `json:"hcTimeout,omitempty"`
+	HCTLSServerName     *string                `json:"hcTlsServerName,omitempty"`
+	HCUnhealthyInterval *int                   `json:"hcUnhealthyInterval,omitempty"`
+	IP                  string                 `json:"ip"`
+	Method              *string                `json:"method,omitempty"`
+	Path                *string                `json:"path,omitempty"`
+	PathMatchType       *TargetPathMatchType   `json:"pathMatchType,omitempty"`
+	Port                *int                   `json:"port,omitempty"`
+	Priority            *int                   `json:"priority,omitempty"`
+	RewritePath         *string                `json:"rewritePath,omitempty"`
+	RewritePathType     *TargetRewritePathType `json:"rewritePathType,omitempty"`
+	SiteID              int                    `json:"siteId"`
+}
+
+// PostTargetHCHeader is the hcHeaders element of PostTarget.
+type PostTargetHCHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// DeleteTarget calls DELETE /target/{targetId}: Delete a target.
+func (a *API) DeleteTarget(ctx context.Context, targetID string) (json.RawMessage, error) {
+	path := "/target/" + url.PathEscape(targetID)
+	return a.client.doRequest(ctx, "DELETE", path, nil)
+}
+
+// GetUser calls GET /user/{userId}: Get a user by ID.
+func (a *API) GetUser(ctx context.Context, userID string) (json.RawMessage, error) {
+	path := "/user/" + url.PathEscape(userID)
+	return a.client.doRequest(ctx, "GET", path, nil)
+}
+
+// PostUser2FA calls POST /user/{userId}/2fa: Update a user's 2FA status.
+func (a *API) PostUser2FA(ctx context.Context, userID string, body *PostUser2FABody) (json.RawMessage, error) {
+	path := "/user/" + url.PathEscape(userID) + "/2fa"
+	return a.client.doRequest(ctx, "POST", path, bodyOf(body))
+}
+
+// PostUser2FABody is the request body of POST /user/{userId}/2fa.
+type PostUser2FABody struct {
+	TwoFactorSetupRequested bool `json:"twoFactorSetupRequested"`
+}
@@ -2,36 +2,119 @@ package client
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
 	BaseURL    string
 	Token      string
 	HTTPClient *http.Client
+
+	// OrgID is the default organization of the provider configuration, used
+	// by resources and data sources that do not set one.
+	OrgID string
+
+	// RequestTimeout limits each request whose context has no deadline of
+	// its own. A resource operation with a timeout sets a deadline for all of
+	// its requests instead.
+	RequestTimeout time.Duration
+
+	// Limiter bounds the rate and concurrency of requests across everything
+	// sharing the Client. Nil means no limit.
+	Limiter *Limiter
 }
 
+// DefaultRequestTimeout is the RequestTimeout of a new Client.
+const DefaultRequestTimeout = 30 * time.Second
+
 func NewClient(baseURL, token string) *Client {
 	return &Client{
-		BaseURL: baseURL,
-		Token:   token,
-		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		BaseURL:        baseURL,
+		Token:          token,
+		HTTPClient:     &http.Client{},
+		RequestTimeout: DefaultRequestTimeout,
 	}
 }
 
+// APIError is returned when the API responds with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (%d): %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is an API error with a 404 status code.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is an API error with a 401 status code,
+// which Pangolin answers for an invalid token.
+func IsUnauthorized(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is an API error with a 403 status code,
+// which Pangolin answers for a valid token without access to the object.
+func IsForbidden(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden
+}
+
 type apiResponse struct {
 	Data    json.RawMessage `json:"data"`
 	Success bool            `json:"success"`
 	Message string          `json:"message"`
 }
 
-func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error) {
+// doRequest sends a request to the API and returns the data of the response
+// envelope. Each request is logged to the pangolin_client subsystem: method,
+// path, status and latency at DEBUG, and the bodies, with secrets masked, at
+// TRACE.
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	// Time spent waiting for the limiter does not count against the request
+	// timeout, only against the caller's own deadline.
+	release, wait, err := c.Limiter.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if _, ok := ctx.Deadline(); !ok && c.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.RequestTimeout)
+		defer cancel()
+	}
+	ctx = c.logContext(ctx)
+	fields := map[string]interface{}{
+		"method": method,
+		"path":   path,
+	}
+	if c.Limiter != nil {
+		waits, total := c.Limiter.Stats()
+		fields["rate_limit_wait_ms"] = wait.Rate.Milliseconds()
+		fields["concurrency_wait_ms"] = wait.Concurrency.Milliseconds()
+		fields["limiter_waits"] = waits
+		fields["limiter_wait_total_ms"] = total.Milliseconds()
+	}
+
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
@@ -39,9 +122,12 @@ func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error
 			return nil, err
 		}
 		bodyReader = bytes.NewReader(jsonBody)
+		tflog.SubsystemTrace(ctx, logSubsystem, "Sending API request body", fields, map[string]interface{}{
+			"body": maskBody(jsonBody),
+		})
 	}
 
-	req, err := http.NewRequest(method, c.BaseURL+path, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, bodyReader)
 	if err != nil {
 		return nil, err
 	}
@@ -49,19 +135,30 @@ func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 	req.Header.Set("Content-Type", "application/json")
 
+	start := time.Now()
 	resp, err := c.HTTPClient.Do(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
 	if err != nil {
+		tflog.SubsystemDebug(ctx, logSubsystem, "API request failed", fields, map[string]interface{}{
+			"error": err.Error(),
+		})
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
+	fields["status"] = resp.StatusCode
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+	tflog.SubsystemDebug(ctx, logSubsystem, "API request", fields)
 	if err != nil {
 		return nil, err
 	}
+	tflog.SubsystemTrace(ctx, logSubsystem, "Received API response body", fields, map[string]interface{}{
+		"body": maskBody(respBody),
+	})
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, string(respBody))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	var apiResp apiResponse
@@ -76,112 +173,563 @@ func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error
 	return apiResp.Data, nil
 }
 
+// listPageSize is the page size used by listPaged.
+const listPageSize = 1000
+
+// listPaged fetches every page of a list endpoint through fetch and decodes
+// the items found under key in each response.
+func listPaged[T any](key string, fetch func(limit, offset string) (json.RawMessage, error)) ([]T, error) {
+	var all []T
+	offset := 0
+	for {
+		data, err := fetch(strconv.Itoa(listPageSize), strconv.Itoa(offset))
+		if err != nil {
+			return nil, err
+		}
+		var wrapper map[string]json.RawMessage
+		if err := json.Unmarshal(data, &wrapper); err != nil {
+			return nil, err
+		}
+		var items []T
+		if raw, ok := wrapper[key]; ok {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return nil, err
+			}
+		}
+		all = append(all, items...)
+		if len(items) < listPageSize {
+			return all, nil
+		}
+		offset += len(items)
+	}
+}
+
 // Role definitions
+// MemberRoleName is the name of the role Pangolin creates in every
+// organization for users without other permissions.
+const MemberRoleName = "Member"
+
 type Role struct {
 	ID          int    `json:"roleId,omitempty"`
+	OrgID       string `json:"orgId,omitempty"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	IsAdmin     bool   `json:"isAdmin"`
 }
 
-func (c *Client) CreateRole(orgID string, role *Role) (*Role, error) {
-	path := fmt.Sprintf("/org/%s/role", orgID)
-	body := map[string]interface{}{
-		"name":        role.Name,
-		"description": role.Description,
+func (c *Client) CreateRole(ctx context.Context, orgID string, role *Role) (*Role, error) {
+	return decode[Role](c.API().PutOrgRole(ctx, orgID, &PutOrgRoleBody{
+		Name:        role.Name,
+		Description: &role.Description,
+	}))
+}
+
+func (c *Client) GetRole(ctx context.Context, orgID string, roleID int) (*Role, error) {
+	return decode[Role](c.API().GetRole(ctx, strconv.Itoa(roleID)))
+}
+
+func (c *Client) UpdateRole(ctx context.Context, orgID string, roleID int, role *Role) (*Role, error) {
+	return decode[Role](c.API().PostRole(ctx, strconv.Itoa(roleID), &PostRoleBody{
+		Name:        &role.Name,
+		Description: &role.Description,
+	}))
+}
+
+// DeleteRole deletes a role. Pangolin requires a replacement role for the
+// users of the deleted role, so they are moved to the organization's built-in
+// Member role.
+func (c *Client) DeleteRole(ctx context.Context, orgID string, roleID int) error {
+	roles, err := c.ListRoles(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	replacement := 0
+	for _, role := range roles {
+		if role.Name == MemberRoleName && !role.IsAdmin {
+			replacement = role.ID
+			break
+		}
+	}
+	if replacement == 0 || replacement == roleID {
+		return fmt.Errorf("organization %q has no %s role to move the users of role %d to", orgID, MemberRoleName, roleID)
 	}
-	data, err := c.doRequest("PUT", path, body)
+	_, err = c.API().DeleteRole(ctx, strconv.Itoa(roleID), &DeleteRoleBody{RoleID: strconv.Itoa(replacement)})
+	return err
+}
+
+func (c *Client) ListRoles(ctx context.Context, orgID string) ([]Role, error) {
+	wrapper, err := decode[struct {
+		Roles []Role `json:"roles"`
+	}](c.API().GetOrgRoles(ctx, orgID, nil))
 	if err != nil {
 		return nil, err
 	}
-	var out Role
-	err = json.Unmarshal(data, &out)
-	return &out, err
+	return wrapper.Roles, nil
 }
 
-func (c *Client) GetRole(orgID string, roleID int) (*Role, error) {
-	path := fmt.Sprintf("/role/%d", roleID)
-	data, err := c.doRequest("GET", path, nil)
+// OrgUser definitions
+type OrgUser struct {
+	ID       string `json:"userId"`
+	OrgID    string `json:"orgId"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	IdpID    int    `json:"idpId"`
+	RoleID   int    `json:"roleId"`
+	RoleName string `json:"roleName"`
+	IsOwner  bool   `json:"isOwner"`
+}
+
+// CreateOrgUser creates an IdP-backed user in the organization. The API does
+// not return the new user, so it is looked up by username and IdP afterwards.
+func (c *Client) CreateOrgUser(ctx context.Context, orgID string, user *OrgUser) (*OrgUser, error) {
+	body := &PutOrgUserBody{
+		Username: user.Username,
+		Type:     ptr(UserType(user.Type)),
+		RoleID:   float64(user.RoleID),
+	}
+	if user.Email != "" {
+		body.Email = &user.Email
+	}
+	if user.Name != "" {
+		body.Name = &user.Name
+	}
+	if user.IdpID != 0 {
+		body.IdpID = ptr(float64(user.IdpID))
+	}
+	if _, err := c.API().PutOrgUser(ctx, orgID, body); err != nil {
+		return nil, err
+	}
+
+	users, err := c.ListOrgUsers(ctx, orgID)
 	if err != nil {
 		return nil, err
 	}
-	var out Role
-	err = json.Unmarshal(data, &out)
-	return &out, err
+	for _, u := range users {
+		if u.Username == user.Username && u.IdpID == user.IdpID {
+			return &u, nil
+		}
+	}
+	return nil, fmt.Errorf("user %q was created but could not be found in organization %q", user.Username, orgID)
+}
+
+func (c *Client) GetOrgUser(ctx context.Context, orgID string, userID string) (*OrgUser, error) {
+	return decode[OrgUser](c.API().GetOrgUser(ctx, orgID, userID))
 }
 
-func (c *Client) UpdateRole(orgID string, roleID int, role *Role) (*Role, error) {
-	path := fmt.Sprintf("/role/%d", roleID)
-	body := map[string]interface{}{
-		"name":        role.Name,
-		"description": role.Description,
+func (c *Client) ListOrgUsers(ctx context.Context, orgID string) ([]OrgUser, error) {
+	// The list endpoint returns the user ID as "id" rather than "userId".
+	wrapper, err := decode[struct {
+		Users []struct {
+			OrgUser
+			ListID string `json:"id"`
+		} `json:"users"`
+	}](c.API().GetOrgUsers(ctx, orgID, nil))
+	if err != nil {
+		return nil, err
+	}
+	users := make([]OrgUser, len(wrapper.Users))
+	for i, u := range wrapper.Users {
+		users[i] = u.OrgUser
+		if users[i].ID == "" {
+			users[i].ID = u.ListID
+		}
 	}
-	data, err := c.doRequest("POST", path, body)
+	return users, nil
+}
+
+// CheckOrgUserAccess reports whether the user may access the organization.
+func (c *Client) CheckOrgUserAccess(ctx context.Context, orgID string, userID string) (bool, error) {
+	out, err := decode[struct {
+		Allowed bool `json:"allowed"`
+	}](c.API().GetOrgUserCheck(ctx, orgID, userID))
+	if err != nil {
+		return false, err
+	}
+	return out.Allowed, nil
+}
+
+func (c *Client) DeleteOrgUser(ctx context.Context, orgID string, userID string) error {
+	_, err := c.API().DeleteOrgUser(ctx, orgID, userID)
+	return err
+}
+
+// AddRoleToUser assigns the role to the user. A user holds a single role per
+// organization, so this replaces any role the user had before.
+func (c *Client) AddRoleToUser(ctx context.Context, roleID int, userID string) error {
+	_, err := c.API().PostRoleAdd(ctx, strconv.Itoa(roleID), userID)
+	return err
+}
+
+// User definitions
+type User struct {
+	ID                      string `json:"userId"`
+	Email                   string `json:"email"`
+	Username                string `json:"username"`
+	Name                    string `json:"name"`
+	Type                    string `json:"type"`
+	TwoFactorEnabled        bool   `json:"twoFactorEnabled"`
+	TwoFactorSetupRequested bool   `json:"twoFactorSetupRequested"`
+}
+
+func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
+	return decode[User](c.API().GetUser(ctx, userID))
+}
+
+// SetUserTwoFactorSetupRequested asks the user to enroll in two-factor
+// authentication on their next sign in, or withdraws that request.
+func (c *Client) SetUserTwoFactorSetupRequested(ctx context.Context, userID string, requested bool) error {
+	_, err := c.API().PostUser2FA(ctx, userID, &PostUser2FABody{TwoFactorSetupRequested: requested})
+	return err
+}
+
+// Invitation definitions
+type Invitation struct {
+	ID         string `json:"inviteId"`
+	Email      string `json:"email"`
+	RoleID     int    `json:"roleId"`
+	ExpiresAt  int64  `json:"expiresAt"`
+	InviteLink string `json:"inviteLink,omitempty"`
+}
+
+// CreateInvitation invites a user to the organization. Any open invitation for
+// the same email is regenerated. The API only returns the link and expiry, so
+// the invitation ID is looked up by email afterwards.
+func (c *Client) CreateInvitation(ctx context.Context, orgID string, email string, roleID int, validHours int, sendEmail bool) (*Invitation, error) {
+	created, err := decode[Invitation](c.API().PostOrgCreateInvite(ctx, orgID, &PostOrgCreateInviteBody{
+		Email:      email,
+		RoleID:     float64(roleID),
+		ValidHours: float64(validHours),
+		SendEmail:  &sendEmail,
+		Regenerate: ptr(true),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := c.ListInvitations(ctx, orgID)
 	if err != nil {
 		return nil, err
 	}
-	var out Role
-	err = json.Unmarshal(data, &out)
-	return &out, err
+	for _, inv := range invitations {
+		if strings.EqualFold(inv.Email, email) {
+			inv.InviteLink = created.InviteLink
+			if created.ExpiresAt != 0 {
+				inv.ExpiresAt = created.ExpiresAt
+			}
+			return &inv, nil
+		}
+	}
+	return nil, fmt.Errorf("invitation for %q was created but could not be found in organization %q", email, orgID)
 }
 
-func (c *Client) DeleteRole(orgID string, roleID int) error {
-	path := fmt.Sprintf("/role/%d", roleID)
-	// Workaround: Pangolin requires a replacement role ID for users in the deleted role.
-	// We use ID 2 (Member) which is standard in a fresh org.
-	body := map[string]interface{}{
-		"roleId": "2",
+func (c *Client) ListInvitations(ctx context.Context, orgID string) ([]Invitation, error) {
+	wrapper, err := decode[struct {
+		Invitations []Invitation `json:"invitations"`
+	}](c.API().GetOrgInvitations(ctx, orgID, nil))
+	if err != nil {
+		return nil, err
 	}
-	_, err := c.doRequest("DELETE", path, body)
+	return wrapper.Invitations, nil
+}
+
+func (c *Client) DeleteInvitation(ctx context.Context, orgID string, inviteID string) error {
+	_, err := c.API().DeleteOrgInvitations(ctx, orgID, inviteID)
 	return err
 }
 
-func (c *Client) ListRoles(orgID string) ([]Role, error) {
-	path := fmt.Sprintf("/org/%s/roles", orgID)
-	data, err := c.doRequest("GET", path, nil)
+// IdP definitions
+type OIDCIdp struct {
+	ID             int    `json:"idpId,omitempty"`
+	Name           string `json:"name"`
+	ClientID       string `json:"clientId"`
+	ClientSecret   string `json:"clientSecret,omitempty"`
+	AuthURL        string `json:"authUrl"`
+	TokenURL       string `json:"tokenUrl"`
+	IdentifierPath string `json:"identifierPath"`
+	EmailPath      string `json:"emailPath,omitempty"`
+	NamePath       string `json:"namePath,omitempty"`
+	Scopes         string `json:"scopes"`
+	AutoProvision  bool   `json:"autoProvision"`
+	RedirectURL    string `json:"redirectUrl,omitempty"`
+}
+
+func (c *Client) CreateOIDCIdp(ctx context.Context, idp *OIDCIdp) (*OIDCIdp, error) {
+	return decode[OIDCIdp](c.API().PutIdpOIDC(ctx, &PutIdpOIDCBody{
+		Name:           idp.Name,
+		ClientID:       idp.ClientID,
+		ClientSecret:   idp.ClientSecret,
+		AuthURL:        idp.AuthURL,
+		TokenURL:       idp.TokenURL,
+		IdentifierPath: idp.IdentifierPath,
+		EmailPath:      optional(idp.EmailPath),
+		NamePath:       optional(idp.NamePath),
+		Scopes:         idp.Scopes,
+		AutoProvision:  &idp.AutoProvision,
+	}))
+}
+
+func (c *Client) GetOIDCIdp(ctx context.Context, idpID int) (*OIDCIdp, error) {
+	wrapper, err := decode[struct {
+		Idp struct {
+			ID            int    `json:"idpId"`
+			Name          string `json:"name"`
+			AutoProvision bool   `json:"autoProvision"`
+		} `json:"idp"`
+		IdpOidcConfig struct {
+			ClientID       string `json:"clientId"`
+			AuthURL        string `json:"authUrl"`
+			TokenURL       string `json:"tokenUrl"`
+			IdentifierPath string `json:"identifierPath"`
+			EmailPath      string `json:"emailPath"`
+			NamePath       string `json:"namePath"`
+			Scopes         string `json:"scopes"`
+		} `json:"idpOidcConfig"`
+		RedirectURL string `json:"redirectUrl"`
+	}](c.API().GetIdpByIdpID(ctx, idpID))
 	if err != nil {
 		return nil, err
 	}
-	var wrapper struct {
-		Roles []Role `json:"roles"`
+	return &OIDCIdp{
+		ID:             wrapper.Idp.ID,
+		Name:           wrapper.Idp.Name,
+		ClientID:       wrapper.IdpOidcConfig.ClientID,
+		AuthURL:        wrapper.IdpOidcConfig.AuthURL,
+		TokenURL:       wrapper.IdpOidcConfig.TokenURL,
+		IdentifierPath: wrapper.IdpOidcConfig.IdentifierPath,
+		EmailPath:      wrapper.IdpOidcConfig.EmailPath,
+		NamePath:       wrapper.IdpOidcConfig.NamePath,
+		Scopes:         wrapper.IdpOidcConfig.Scopes,
+		AutoProvision:  wrapper.Idp.AutoProvision,
+		RedirectURL:    wrapper.RedirectURL,
+	}, nil
+}
+
+// UpdateOIDCIdp updates the IdP. The client secret is left unchanged when
+// idp.ClientSecret is empty.
+func (c *Client) UpdateOIDCIdp(ctx context.Context, idpID int, idp *OIDCIdp) error {
+	_, err := c.API().PostIdpOIDC(ctx, idpID, &PostIdpOIDCBody{
+		Name:           &idp.Name,
+		ClientID:       &idp.ClientID,
+		ClientSecret:   optional(idp.ClientSecret),
+		AuthURL:        &idp.AuthURL,
+		TokenURL:       &idp.TokenURL,
+		IdentifierPath: &idp.IdentifierPath,
+		EmailPath:      &idp.EmailPath,
+		NamePath:       &idp.NamePath,
+		Scopes:         &idp.Scopes,
+		AutoProvision:  &idp.AutoProvision,
+	})
+	return err
+}
+
+func (c *Client) DeleteIdp(ctx context.Context, idpID int) error {
+	_, err := c.API().DeleteIdp(ctx, idpID)
+	return err
+}
+
+type IdpOrgPolicy struct {
+	IdpID       int    `json:"idpId"`
+	OrgID       string `json:"orgId"`
+	RoleMapping string `json:"roleMapping"`
+	OrgMapping  string `json:"orgMapping"`
+}
+
+func (c *Client) CreateIdpOrgPolicy(ctx context.Context, idpID int, orgID string, policy *IdpOrgPolicy) error {
+	_, err := c.API().PutIdpOrg(ctx, idpID, orgID, &PutIdpOrgBody{
+		RoleMapping: &policy.RoleMapping,
+		OrgMapping:  &policy.OrgMapping,
+	})
+	return err
+}
+
+func (c *Client) UpdateIdpOrgPolicy(ctx context.Context, idpID int, orgID string, policy *IdpOrgPolicy) error {
+	_, err := c.API().PostIdpOrg(ctx, idpID, orgID, &PostIdpOrgBody{
+		RoleMapping: &policy.RoleMapping,
+		OrgMapping:  &policy.OrgMapping,
+	})
+	return err
+}
+
+func (c *Client) DeleteIdpOrgPolicy(ctx context.Context, idpID int, orgID string) error {
+	_, err := c.API().DeleteIdpOrg(ctx, idpID, orgID)
+	return err
+}
+
+func (c *Client) ListIdpOrgPolicies(ctx context.Context, idpID int) ([]IdpOrgPolicy, error) {
+	wrapper, err := decode[struct {
+		Policies []IdpOrgPolicy `json:"policies"`
+	}](c.API().GetIdpOrg(ctx, idpID, nil))
+	if err != nil {
+		return nil, err
 	}
-	err = json.Unmarshal(data, &wrapper)
-	return wrapper.Roles, err
+	return wrapper.Policies, nil
 }
 
-// Site definitions
-type Site struct {
-	ID   int    `json:"siteId"`
-	Name string `json:"name"`
+// APIKey definitions
+type APIKey struct {
+	ID        string `json:"apiKeyId"`
+	Name      string `json:"name"`
+	Key       string `json:"key,omitempty"`
+	LastChars string `json:"lastChars"`
+	CreatedAt string `json:"createdAt"`
 }
 
-func (c *Client) ListSites(orgID string) ([]Site, error) {
-	path := fmt.Sprintf("/org/%s/sites", orgID)
-	data, err := c.doRequest("GET", path, nil)
+func (c *Client) CreateAPIKey(ctx context.Context, orgID string, name string) (*APIKey, error) {
+	return decode[APIKey](c.API().PutOrgAPIKey(ctx, orgID, &PutOrgAPIKeyBody{Name: name}))
+}
+
+func (c *Client) ListAPIKeys(ctx context.Context, orgID string) ([]APIKey, error) {
+	wrapper, err := decode[struct {
+		APIKeys []APIKey `json:"apiKeys"`
+	}](c.API().GetOrgAPIKeys(ctx, orgID, nil))
 	if err != nil {
 		return nil, err
 	}
-	var wrapper struct {
-		Sites []Site `json:"sites"`
+	return wrapper.APIKeys, nil
+}
+
+func (c *Client) DeleteAPIKey(ctx context.Context, orgID string, apiKeyID string) error {
+	_, err := c.API().DeleteOrgAPIKey(ctx, orgID, apiKeyID)
+	return err
+}
+
+func (c *Client) GetAPIKeyActions(ctx context.Context, orgID string, apiKeyID string) ([]string, error) {
+	wrapper, err := decode[struct {
+		Actions []struct {
+			ActionID string `json:"actionId"`
+		} `json:"actions"`
+	}](c.API().GetOrgAPIKeyActions(ctx, orgID, apiKeyID, nil))
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(wrapper.Actions))
+	for i, a := range wrapper.Actions {
+		ids[i] = a.ActionID
 	}
-	err = json.Unmarshal(data, &wrapper)
-	return wrapper.Sites, err
+	return ids, nil
 }
 
-func (c *Client) CreateSite(orgID string, name string) (*Site, error) {
-	path := fmt.Sprintf("/org/%s/site", orgID)
-	body := map[string]interface{}{
-		"name":   name,
-		"type":   "newt",
-		"newtId": "test-newt-" + time.Now().Format("150405"),
-		"secret": "test-secret-123",
+// SetAPIKeyActions replaces the actions granted to the API key.
+func (c *Client) SetAPIKeyActions(ctx context.Context, orgID string, apiKeyID string, actionIDs []string) error {
+	_, err := c.API().PostOrgAPIKeyActions(ctx, orgID, apiKeyID, &PostOrgAPIKeyActionsBody{ActionIDs: actionIDs})
+	return err
+}
+
+// Blueprint definitions
+type Blueprint struct {
+	ID        int    `json:"blueprintId"`
+	Name      string `json:"name"`
+	Source    string `json:"source"`
+	Succeeded bool   `json:"succeeded"`
+	Contents  string `json:"contents"`
+	Message   string `json:"message"`
+}
+
+// ApplyBlueprint applies a JSON blueprint to the organization. The API expects
+// the document base64 encoded.
+func (c *Client) ApplyBlueprint(ctx context.Context, orgID string, blueprint []byte) (*Blueprint, error) {
+	return decode[Blueprint](c.API().PutOrgBlueprint(ctx, orgID, &PutOrgBlueprintBody{
+		Blueprint: base64.StdEncoding.EncodeToString(blueprint),
+	}))
+}
+
+func (c *Client) GetBlueprint(ctx context.Context, orgID string, blueprintID int) (*Blueprint, error) {
+	return decode[Blueprint](c.API().GetOrgBlueprint(ctx, orgID, strconv.Itoa(blueprintID)))
+}
+
+func (c *Client) ListBlueprints(ctx context.Context, orgID string) ([]Blueprint, error) {
+	wrapper, err := decode[struct {
+		Blueprints []Blueprint `json:"blueprints"`
+	}](c.API().GetOrgBlueprints(ctx, orgID, nil))
+	if err != nil {
+		return nil, err
 	}
-	data, err := c.doRequest("PUT", path, body)
+	return wrapper.Blueprints, nil
+}
+
+// Domain definitions
+type Domain struct {
+	ID                 string  `json:"domainId"`
+	BaseDomain         string  `json:"baseDomain"`
+	Type               string  `json:"type"`
+	Verified           bool    `json:"verified"`
+	Failed             bool    `json:"failed"`
+	ConfigManaged      bool    `json:"configManaged"`
+	CertResolver       *string `json:"certResolver"`
+	PreferWildcardCert *bool   `json:"preferWildcardCert"`
+}
+
+type DNSRecord struct {
+	ID         int    `json:"id"`
+	RecordType string `json:"recordType"`
+	BaseDomain string `json:"baseDomain"`
+	Value      string `json:"value"`
+	Verified   bool   `json:"verified"`
+}
+
+func (c *Client) ListDomains(ctx context.Context, orgID string) ([]Domain, error) {
+	wrapper, err := decode[struct {
+		Domains []Domain `json:"domains"`
+	}](c.API().GetOrgDomains(ctx, orgID, nil))
 	if err != nil {
 		return nil, err
 	}
-	var out Site
-	err = json.Unmarshal(data, &out)
-	return &out, err
+	return wrapper.Domains, nil
+}
+
+func (c *Client) GetDomain(ctx context.Context, orgID string, domainID string) (*Domain, error) {
+	return decode[Domain](c.API().GetOrgDomain(ctx, orgID, domainID))
+}
+
+// GetDomainDNSRecords returns the DNS records Pangolin expects to exist for
+// the domain to verify.
+func (c *Client) GetDomainDNSRecords(ctx context.Context, orgID string, domainID string) ([]DNSRecord, error) {
+	out, err := decode[[]DNSRecord](c.API().GetOrgDomainDNSRecords(ctx, orgID, domainID))
+	if err != nil {
+		return nil, err
+	}
+	return *out, nil
+}
+
+// Site definitions
+type Site struct {
+	ID                  int     `json:"siteId"`
+	NiceID              string  `json:"niceId"`
+	OrgID               string  `json:"orgId"`
+	Name                string  `json:"name"`
+	Type                string  `json:"type"`
+	Online              bool    `json:"online"`
+	Subnet              *string `json:"subnet"`
+	Address             *string `json:"address"`
+	MegabytesIn         float64 `json:"megabytesIn"`
+	MegabytesOut        float64 `json:"megabytesOut"`
+	LastBandwidthUpdate *string `json:"lastBandwidthUpdate"`
+	DockerSocketEnabled bool    `json:"dockerSocketEnabled"`
+}
+
+func (c *Client) GetSite(ctx context.Context, siteID int) (*Site, error) {
+	return decode[Site](c.API().GetSite(ctx, siteID))
+}
+
+func (c *Client) GetSiteByNiceID(ctx context.Context, orgID string, niceID string) (*Site, error) {
+	return decode[Site](c.API().GetOrgSite(ctx, orgID, niceID))
+}
+
+func (c *Client) ListSites(ctx context.Context, orgID string) ([]Site, error) {
+	return listPaged[Site]("sites", func(limit, offset string) (json.RawMessage, error) {
+		return c.API().GetOrgSites(ctx, orgID, &GetOrgSitesQuery{Limit: limit, Offset: offset})
+	})
+}
+
+func (c *Client) CreateSite(ctx context.Context, orgID string, name string) (*Site, error) {
+	return decode[Site](c.API().PutOrgSite(ctx, orgID, &PutOrgSiteBody{
+		Name:   name,
+		Type:   SiteTypeNewt,
+		NewtID: ptr("test-newt-" + time.Now().Format("150405")),
+		Secret: ptr("test-secret-123"),
+	}))
 }
 
 // SiteResource definitions
@@ -202,83 +750,72 @@ type SiteResource struct {
 	DisableIcmp        bool     `json:"disableIcmp,omitempty"`
 }
 
-func (c *Client) CreateSiteResource(orgID string, res *SiteResource) (*SiteResource, error) {
-	path := fmt.Sprintf("/org/%s/private-resource", orgID)
-	body := map[string]interface{}{
-		"name":        res.Name,
-		"mode":        res.Mode,
-		"siteId":      res.SiteID,
-		"destination": res.Destination,
-		"enabled":     res.Enabled,
-		"userIds":     res.UserIDs,
-		"roleIds":     res.RoleIDs,
-		"clientIds":   res.ClientIDs,
-	}
-	if res.Alias != nil {
-		body["alias"] = *res.Alias
-	}
-	data, err := c.doRequest("PUT", path, body)
-	if err != nil {
-		return nil, err
-	}
-	var out SiteResource
-	err = json.Unmarshal(data, &out)
-	return &out, err
+// CreateSiteResource creates a site resource. The bundled spec documents
+// PUT /org/{orgId}/site-resource; the request goes to the private-resource
+// name of current Pangolin releases, with the documented body.
+func (c *Client) CreateSiteResource(ctx context.Context, orgID string, res *SiteResource) (*SiteResource, error) {
+	path := "/org/" + url.PathEscape(orgID) + "/private-resource"
+	return decode[SiteResource](c.doRequest(ctx, "PUT", path, &PutOrgSiteResourceBody{
+		Name:        res.Name,
+		Mode:        SiteResourceMode(res.Mode),
+		SiteID:      res.SiteID,
+		Destination: res.Destination,
+		Enabled:     &res.Enabled,
+		Alias:       res.Alias,
+		UserIDs:     res.UserIDs,
+		RoleIDs:     res.RoleIDs,
+		ClientIDs:   res.ClientIDs,
+	}))
 }
 
-func (c *Client) GetSiteResource(orgID string, siteID int, resID int) (*SiteResource, error) {
-	path := fmt.Sprintf("/site-resource/%d", resID)
-	data, err := c.doRequest("GET", path, nil)
-	if err != nil {
-		return nil, err
-	}
-	var out SiteResource
-	err = json.Unmarshal(data, &out)
-	return &out, err
+func (c *Client) GetSiteResource(ctx context.Context, orgID string, siteID int, resID int) (*SiteResource, error) {
+	return decode[SiteResource](c.API().GetSiteResource(ctx, resID))
 }
 
-func (c *Client) UpdateSiteResource(resID int, res *SiteResource) (*SiteResource, error) {
-	path := fmt.Sprintf("/site-resource/%d", resID)
-	body := map[string]interface{}{
-		"name":        res.Name,
-		"siteId":      res.SiteID,
-		"mode":        res.Mode,
-		"destination": res.Destination,
-		"enabled":     res.Enabled,
-		"userIds":     res.UserIDs,
-		"roleIds":     res.RoleIDs,
-		"clientIds":   res.ClientIDs,
-	}
-	if res.Alias != nil {
-		body["alias"] = *res.Alias
-	}
-	data, err := c.doRequest("POST", path, body)
-	if err != nil {
-		return nil, err
-	}
-	var out SiteResource
-	err = json.Unmarshal(data, &out)
-	return &out, err
+// GetSiteResourceByNiceID looks up a site resource by its nice ID, which is
+// only unique within its site.
+func (c *Client) GetSiteResourceByNiceID(ctx context.Context, orgID string, siteID int, niceID string) (*SiteResource, error) {
+	return decode[SiteResource](c.API().GetOrgSiteResourceNice(ctx, orgID, siteID, niceID))
 }
 
-func (c *Client) DeleteSiteResource(resID int) error {
-	path := fmt.Sprintf("/site-resource/%d", resID)
-	_, err := c.doRequest("DELETE", path, nil)
+func (c *Client) UpdateSiteResource(ctx context.Context, resID int, res *SiteResource) (*SiteResource, error) {
+	return decode[SiteResource](c.API().PostSiteResource(ctx, resID, &PostSiteResourceBody{
+		Name:        &res.Name,
+		SiteID:      res.SiteID,
+		Mode:        ptr(SiteResourceMode(res.Mode)),
+		Destination: &res.Destination,
+		Enabled:     &res.Enabled,
+		Alias:       res.Alias,
+		UserIDs:     res.UserIDs,
+		RoleIDs:     res.RoleIDs,
+		ClientIDs:   res.ClientIDs,
+	}))
+}
+
+func (c *Client) DeleteSiteResource(ctx context.Context, resID int) error {
+	_, err := c.API().DeleteSiteResource(ctx, resID)
 	return err
 }
 
-func (c *Client) GetSiteResourceRoles(resID int) ([]int, error) {
-	path := fmt.Sprintf("/site-resource/%d/roles", resID)
-	data, err := c.doRequest("GET", path, nil)
-	if err != nil {
-		return nil, err
-	}
-	var wrapper struct {
+func (c *Client) ListSiteResources(ctx context.Context, orgID string) ([]SiteResource, error) {
+	return listPaged[SiteResource]("siteResources", func(limit, offset string) (json.RawMessage, error) {
+		return c.API().GetOrgSiteResourcesByOrgID(ctx, orgID, &GetOrgSiteResourcesByOrgIDQuery{Limit: limit, Offset: offset})
+	})
+}
+
+func (c *Client) ListSiteResourcesBySite(ctx context.Context, orgID string, siteID int) ([]SiteResource, error) {
+	return listPaged[SiteResource]("siteResources", func(limit, offset string) (json.RawMessage, error) {
+		return c.API().GetOrgSiteResourcesBySiteID(ctx, orgID, siteID, &GetOrgSiteResourcesBySiteIDQuery{Limit: limit, Offset: offset})
+	})
+}
+
+func (c *Client) GetSiteResourceRoles(ctx context.Context, resID int) ([]int, error) {
+	wrapper, err := decode[struct {
 		Roles []struct {
 			RoleID int `json:"roleId"`
 		} `json:"roles"`
-	}
-	if err := json.Unmarshal(data, &wrapper); err != nil {
+	}](c.API().GetSiteResourceRoles(ctx, resID))
+	if err != nil {
 		return nil, err
 	}
 	ids := make([]int, 0, len(wrapper.Roles))
@@ -292,18 +829,13 @@ func (c *Client) GetSiteResourceRoles(resID int) ([]int, error) {
 	return ids, nil
 }
 
-func (c *Client) GetSiteResourceUsers(resID int) ([]string, error) {
-	path := fmt.Sprintf("/site-resource/%d/users", resID)
-	data, err := c.doRequest("GET", path, nil)
-	if err != nil {
-		return nil, err
-	}
-	var wrapper struct {
+func (c *Client) GetSiteResourceUsers(ctx context.Context, resID int) ([]string, error) {
+	wrapper, err := decode[struct {
 		Users []struct {
 			UserID string `json:"userId"`
 		} `json:"users"`
-	}
-	if err := json.Unmarshal(data, &wrapper); err != nil {
+	}](c.API().GetSiteResourceUsers(ctx, resID))
+	if err != nil {
 		return nil, err
 	}
 	ids := make([]string, len(wrapper.Users))
@@ -313,18 +845,13 @@ func (c *Client) GetSiteResourceUsers(resID int) ([]string, error) {
 	return ids, nil
 }
 
-func (c *Client) GetSiteResourceClients(resID int) ([]int, error) {
-	path := fmt.Sprintf("/site-resource/%d/clients", resID)
-	data, err := c.doRequest("GET", path, nil)
-	if err != nil {
-		return nil, err
-	}
-	var wrapper struct {
+func (c *Client) GetSiteResourceClients(ctx context.Context, resID int) ([]int, error) {
+	wrapper, err := decode[struct {
 		Clients []struct {
 			ClientID int `json:"clientId"`
 		} `json:"clients"`
-	}
-	if err := json.Unmarshal(data, &wrapper); err != nil {
+	}](c.API().GetSiteResourceClients(ctx, resID))
+	if err != nil {
 		return nil, err
 	}
 	ids := make([]int, len(wrapper.Clients))
@@ -342,44 +869,81 @@ type Resource struct {
 	Http      bool   `json:"http"`
 	Subdomain string `json:"subdomain"`
 	DomainID  string `json:"domainId"`
+
+	// ProxyPort is the public port of a raw TCP/UDP resource.
+	ProxyPort *int `json:"proxyPort,omitempty"`
+
+	// Read-only fields, never sent on create or update.
+	NiceID     string `json:"niceId,omitempty"`
+	OrgID      string `json:"orgId,omitempty"`
+	FullDomain string `json:"fullDomain,omitempty"`
+	Enabled    *bool  `json:"enabled,omitempty"`
+	SSL        *bool  `json:"ssl,omitempty"`
+	SSO        *bool  `json:"sso,omitempty"`
+
+	// Authentication other than SSO, only reported by ListResources.
+	PasswordID   *int `json:"passwordId,omitempty"`
+	PincodeID    *int `json:"pincodeId,omitempty"`
+	Whitelist    bool `json:"whitelist,omitempty"`
+	HeaderAuthID *int `json:"headerAuthId,omitempty"`
 }
 
-func (c *Client) CreateResource(orgID string, res *Resource) (*Resource, error) {
-	path := fmt.Sprintf("/org/%s/resource", orgID)
-	data, err := c.doRequest("PUT", path, res)
-	if err != nil {
-		return nil, err
-	}
-	var out Resource
-	err = json.Unmarshal(data, &out)
-	return &out, err
+func (c *Client) CreateResource(ctx context.Context, orgID string, res *Resource) (*Resource, error) {
+	return decode[Resource](c.doRequest(ctx, "PUT", "/org/"+url.PathEscape(orgID)+"/resource", res))
+}
+
+func (c *Client) GetResource(ctx context.Context, resID int) (*Resource, error) {
+	return decode[Resource](c.API().GetResource(ctx, resID))
+}
+
+func (c *Client) GetResourceByNiceID(ctx context.Context, orgID string, niceID string) (*Resource, error) {
+	return decode[Resource](c.API().GetOrgResource(ctx, orgID, niceID))
+}
+
+func (c *Client) UpdateResource(ctx context.Context, resID int, res *Resource) (*Resource, error) {
+	return decode[Resource](c.doRequest(ctx, "POST", "/resource/"+strconv.Itoa(resID), res))
 }
 
-func (c *Client) GetResource(resID int) (*Resource, error) {
-	path := fmt.Sprintf("/resource/%d", resID)
-	data, err := c.doRequest("GET", path, nil)
+func (c *Client) GetResourceUsers(ctx context.Context, resID int) ([]string, error) {
+	wrapper, err := decode[struct {
+		Users []struct {
+			UserID string `json:"userId"`
+		} `json:"users"`
+	}](c.API().GetResourceUsers(ctx, resID))
 	if err != nil {
 		return nil, err
 	}
-	var out Resource
-	err = json.Unmarshal(data, &out)
-	return &out, err
+	ids := make([]string, len(wrapper.Users))
+	for i, u := range wrapper.Users {
+		ids[i] = u.UserID
+	}
+	return ids, nil
 }
 
-func (c *Client) UpdateResource(resID int, res *Resource) (*Resource, error) {
-	path := fmt.Sprintf("/resource/%d", resID)
-	data, err := c.doRequest("POST", path, res)
+func (c *Client) GetResourceRoles(ctx context.Context, resID int) ([]int, error) {
+	wrapper, err := decode[struct {
+		Roles []struct {
+			RoleID int `json:"roleId"`
+		} `json:"roles"`
+	}](c.API().GetResourceRoles(ctx, resID))
 	if err != nil {
 		return nil, err
 	}
-	var out Resource
-	err = json.Unmarshal(data, &out)
-	return &out, err
+	ids := make([]int, len(wrapper.Roles))
+	for i, r := range wrapper.Roles {
+		ids[i] = r.RoleID
+	}
+	return ids, nil
+}
+
+func (c *Client) ListResources(ctx context.Context, orgID string) ([]Resource, error) {
+	return listPaged[Resource]("resources", func(limit, offset string) (json.RawMessage, error) {
+		return c.API().GetOrgResources(ctx, orgID, &GetOrgResourcesQuery{Limit: limit, Offset: offset})
+	})
 }
 
-func (c *Client) DeleteResource(resID int) error {
-	path := fmt.Sprintf("/resource/%d", resID)
-	_, err := c.doRequest("DELETE", path, nil)
+func (c *Client) DeleteResource(ctx context.Context, resID int) error {
+	_, err := c.API().DeleteResource(ctx, resID)
 	return err
 }
 
@@ -417,54 +981,177 @@ type TargetHeader struct {
 	Value string `json:"value"`
 }
 
-func (c *Client) CreateTarget(resID int, target *Target) (*Target, error) {
-	path := fmt.Sprintf("/resource/%d/target", resID)
-	body := map[string]interface{}{
-		"siteId":  target.SiteID,
-		"ip":      target.IP,
-		"port":    target.Port,
-		"enabled": target.Enabled,
+func (c *Client) CreateTarget(ctx context.Context, resID int, target *Target) (*Target, error) {
+	return decode[Target](c.API().PutResourceTarget(ctx, resID, &PutResourceTargetBody{
+		SiteID:  target.SiteID,
+		IP:      target.IP,
+		Port:    target.Port,
+		Enabled: &target.Enabled,
+	}))
+}
+
+func (c *Client) ListTargets(ctx context.Context, resID int) ([]Target, error) {
+	return listPaged[Target]("targets", func(limit, offset string) (json.RawMessage, error) {
+		return c.API().GetResourceTargets(ctx, resID, &GetResourceTargetsQuery{Limit: limit, Offset: offset})
+	})
+}
+
+func (c *Client) GetTarget(ctx context.Context, targetID int) (*Target, error) {
+	return decode[Target](c.API().GetTarget(ctx, strconv.Itoa(targetID)))
+}
+
+func (c *Client) UpdateTarget(ctx context.Context, targetID int, target *Target) (*Target, error) {
+	return decode[Target](c.API().PostTarget(ctx, strconv.Itoa(targetID), &PostTargetBody{
+		SiteID:  target.SiteID,
+		IP:      target.IP,
+		Port:    &target.Port,
+		Enabled: &target.Enabled,
+	}))
+}
+
+func (c *Client) DeleteTarget(ctx context.Context, targetID int) error {
+	_, err := c.API().DeleteTarget(ctx, strconv.Itoa(targetID))
+	return err
+}
+
+// Request log definitions
+type RequestLog struct {
+	ID         int     `json:"id"`
+	Timestamp  int64   `json:"timestamp"`
+	Action     bool    `json:"action"`
+	Reason     int     `json:"reason"`
+	ActorType  *string `json:"actorType"`
+	Actor      *string `json:"actor"`
+	ResourceID *int    `json:"resourceId"`
+	IP         *string `json:"ip"`
+	Location   *string `json:"location"`
+	Method     *string `json:"method"`
+	Host       *string `json:"host"`
+	Path       *string `json:"path"`
+}
+
+// RequestLogQuery filters the request audit log. Zero values are not sent.
+type RequestLogQuery struct {
+	TimeStart  time.Time
+	TimeEnd    time.Time
+	Action     *bool
+	ResourceID int
+	Method     string
+	Location   string
+	Host       string
+	Path       string
+	Actor      string
+}
+
+// requestQuery converts the filter to the query of the request log endpoint.
+func (q RequestLogQuery) requestQuery() *GetOrgLogsRequestQuery {
+	out := &GetOrgLogsRequestQuery{
+		Method:   RequestMethod(q.Method),
+		Location: q.Location,
+		Host:     q.Host,
+		Path:     q.Path,
+		Actor:    q.Actor,
 	}
-	// Add other optional fields if needed...
-	data, err := c.doRequest("PUT", path, body)
-	if err != nil {
-		return nil, err
+	out.TimeStart, out.TimeEnd, out.ResourceID = q.window()
+	if q.Action != nil {
+		out.Action = strconv.FormatBool(*q.Action)
 	}
-	var out Target
-	err = json.Unmarshal(data, &out)
-	return &out, err
+	return out
 }
 
-func (c *Client) GetTarget(targetID int) (*Target, error) {
-	path := fmt.Sprintf("/target/%d", targetID)
-	data, err := c.doRequest("GET", path, nil)
-	if err != nil {
-		return nil, err
-	}
-	var out Target
-	err = json.Unmarshal(data, &out)
-	return &out, err
+// analyticsQuery converts the filter to the query of the analytics endpoint,
+// which only supports the time window and resource filters.
+func (q RequestLogQuery) analyticsQuery() *GetOrgLogsAnalyticsQuery {
+	out := &GetOrgLogsAnalyticsQuery{}
+	out.TimeStart, out.TimeEnd, out.ResourceID = q.window()
+	return out
 }
 
-func (c *Client) UpdateTarget(targetID int, target *Target) (*Target, error) {
-	path := fmt.Sprintf("/target/%d", targetID)
-	body := map[string]interface{}{
-		"siteId":  target.SiteID,
-		"ip":      target.IP,
-		"port":    target.Port,
-		"enabled": target.Enabled,
+func (q RequestLogQuery) window() (start, end, resourceID string) {
+	if !q.TimeStart.IsZero() {
+		start = q.TimeStart.UTC().Format(time.RFC3339)
 	}
-	data, err := c.doRequest("POST", path, body)
-	if err != nil {
-		return nil, err
+	if !q.TimeEnd.IsZero() {
+		end = q.TimeEnd.UTC().Format(time.RFC3339)
 	}
-	var out Target
-	err = json.Unmarshal(data, &out)
-	return &out, err
+	if q.ResourceID != 0 {
+		resourceID = strconv.Itoa(q.ResourceID)
+	}
+	return start, end, resourceID
 }
 
-func (c *Client) DeleteTarget(targetID int) error {
-	path := fmt.Sprintf("/target/%d", targetID)
-	_, err := c.doRequest("DELETE", path, nil)
-	return err
+// ListRequestLogs returns up to limit matching request log entries, newest
+// first. The endpoint returns a bounded page, so older entries are fetched by
+// moving timeEnd back to the oldest entry seen until the window is exhausted.
+// The endpoint has no offset parameter, so when more entries than fit in one
+// page share the oldest second, the remaining ones cannot be reached and an
+// error is returned instead of a silently truncated result.
+// A limit of 0 means no limit.
+func (c *Client) ListRequestLogs(ctx context.Context, orgID string, query RequestLogQuery, limit int) ([]RequestLog, error) {
+	var all []RequestLog
+	seen := make(map[int]bool)
+
+	for {
+		wrapper, err := decode[struct {
+			Log        []RequestLog `json:"log"`
+			Pagination struct {
+				Total int `json:"total"`
+			} `json:"pagination"`
+		}](c.API().GetOrgLogsRequest(ctx, orgID, query.requestQuery()))
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		oldest := int64(0)
+		for _, entry := range wrapper.Log {
+			if oldest == 0 || entry.Timestamp < oldest {
+				oldest = entry.Timestamp
+			}
+			if seen[entry.ID] {
+				continue
+			}
+			seen[entry.ID] = true
+			all = append(all, entry)
+			added++
+			if limit > 0 && len(all) >= limit {
+				return all, nil
+			}
+		}
+
+		// Stop once the page held everything in the window.
+		if len(wrapper.Log) >= wrapper.Pagination.Total {
+			return all, nil
+		}
+		if added == 0 {
+			return nil, fmt.Errorf("more than %d request log entries share the timestamp %s and cannot be paged; narrow the query with time_start, time_end or another filter",
+				len(wrapper.Log), time.Unix(oldest, 0).UTC().Format(time.RFC3339))
+		}
+		query.TimeEnd = time.Unix(oldest, 0)
+	}
+}
+
+type RequestAnalytics struct {
+	TotalRequests      int                   `json:"totalRequests"`
+	TotalBlocked       int                   `json:"totalBlocked"`
+	RequestsPerCountry []CountryRequestCount `json:"requestsPerCountry"`
+	RequestsPerDay     []DailyRequestCount   `json:"requestsPerDay"`
+}
+
+type CountryRequestCount struct {
+	Code  string `json:"code"`
+	Count int    `json:"count"`
+}
+
+type DailyRequestCount struct {
+	Day          string `json:"day"`
+	AllowedCount int    `json:"allowedCount"`
+	BlockedCount int    `json:"blockedCount"`
+	TotalCount   int    `json:"totalCount"`
+}
+
+// GetRequestAnalytics aggregates the request audit log. Only the time window
+// and resource filters of the query are supported by the endpoint.
+func (c *Client) GetRequestAnalytics(ctx context.Context, orgID string, query RequestLogQuery) (*RequestAnalytics, error) {
+	return decode[RequestAnalytics](c.API().GetOrgLogsAnalytics(ctx, orgID, query.analyticsQuery()))
 }
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListRequestLogs_PagesByTime(t *testing.T) {
+	// 5 entries, one per second, newest first; the server returns pages of 2.
+	var entries []RequestLog
+	for i := 5; i >= 1; i-- {
+		entries = append(entries, RequestLog{ID: i, Timestamp: int64(1000 + i), Action: i%2 == 0})
+	}
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+
+		end := int64(1 << 62)
+		if v := r.URL.Query().Get("timeEnd"); v != "" {
+			ts, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				t.Fatalf("bad timeEnd %q", v)
+			}
+			end = ts.Unix()
+		}
+
+		var window []RequestLog
+		for _, e := range entries {
+			if e.Timestamp <= end {
+				window = append(window, e)
+			}
+		}
+		page := window
+		if len(page) > 2 {
+			page = page[:2]
+		}
+
+		data, _ := json.Marshal(map[string]interface{}{
+			"log":        page,
+			"pagination": map[string]int{"total": len(window), "limit": 2, "offset": 0},
+		})
+		fmt.Fprintf(w, `{"success":true,"message":"ok","data":%s}`, data)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "token")
+
+	logs, err := c.ListRequestLogs(t.Context(), "org", RequestLogQuery{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 5 {
+		t.Fatalf("expected 5 entries, got %d (requests: %v)", len(logs), requests)
+	}
+	for i, l := range logs {
+		if l.ID != 5-i {
+			t.Errorf("entry %d: expected ID %d, got %d", i, 5-i, l.ID)
+		}
+	}
+
+	logs, err = c.ListRequestLogs(t.Context(), "org", RequestLogQuery{}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 3 {
+		t.Errorf("expected the limit of 3 entries, got %d", len(logs))
+	}
+}
+
+func TestListRequestLogs_SameSecondOverflow(t *testing.T) {
+	// 3 entries share one second but the server returns pages of 2, so the
+	// third cannot be reached by moving timeEnd.
+	entries := []RequestLog{{ID: 3, Timestamp: 1000}, {ID: 2, Timestamp: 1000}, {ID: 1, Timestamp: 1000}}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := json.Marshal(map[string]interface{}{
+			"log":        entries[:2],
+			"pagination": map[string]int{"total": len(entries), "limit": 2, "offset": 0},
+		})
+		fmt.Fprintf(w, `{"success":true,"message":"ok","data":%s}`, data)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "token")
+
+	if _, err := c.ListRequestLogs(t.Context(), "org", RequestLogQuery{}, 0); err == nil {
+		t.Fatal("expected an error instead of a truncated result")
+	}
+
+	// A limit reached within the first page is still served.
+	logs, err := c.ListRequestLogs(t.Context(), "org", RequestLogQuery{}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 2 {
+		t.Errorf("expected 2 entries, got %d", len(logs))
+	}
+}
+
+func TestRequestLogQueryValues(t *testing.T) {
+	blocked := false
+	v := RequestLogQuery{
+		TimeStart:  time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Action:     &blocked,
+		ResourceID: 7,
+		Location:   "DE",
+	}.requestQuery()
+
+	want := "action=false&location=DE&resourceId=7&timeStart=2025-01-02T03%3A04%3A05Z"
+	if got := v.encode(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		fmt.Fprint(w, `{"success":true,"data":{"roles":[]}}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "token")
+	c.RequestTimeout = 50 * time.Millisecond
+	if _, err := c.ListRoles(t.Context(), "acme"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the request timeout to apply, got %v", err)
+	}
+
+	// A deadline of the caller, such as a resource timeout, replaces it.
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	if _, err := c.ListRoles(ctx, "acme"); err != nil {
+		t.Errorf("expected the context deadline to apply, got %v", err)
+	}
+}
@@ -0,0 +1,726 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/openapi"
+)
+
+// The contract tests run every Client method through a transport that checks
+// each request against the bundled OpenAPI document and answers with sample
+// payloads shaped like Pangolin's responses. A request the spec rejects, or a
+// sample that no longer decodes into our structs, fails the test.
+
+var (
+	contractSpecOnce sync.Once
+	contractSpec     *openapi.Spec
+	contractSpecErr  error
+)
+
+func loadContractSpec(t *testing.T) *openapi.Spec {
+	t.Helper()
+	contractSpecOnce.Do(func() {
+		contractSpec, contractSpecErr = openapi.Load(filepath.Join("..", "..", openapi.DefaultPath))
+	})
+	if contractSpecErr != nil {
+		t.Fatal(contractSpecErr)
+	}
+	return contractSpec
+}
+
+// contractTransport validates requests against the spec and replies with the
+// queued response payloads in order, wrapped in the API envelope. Once the
+// queue is empty it replies with an empty object.
+type contractTransport struct {
+	t         *testing.T
+	spec      *openapi.Spec
+	responses []string
+	requests  []string
+
+	// mismatch, when set, explains a known spec violation; the violation is
+	// logged instead of failing the test.
+	mismatch string
+}
+
+func (tr *contractTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+	}
+	tr.requests = append(tr.requests, req.Method+" "+req.URL.RequestURI())
+
+	if got := req.Header.Get("Authorization"); got != "Bearer contract-token" {
+		tr.t.Errorf("%s %s: unexpected Authorization header %q", req.Method, req.URL.Path, got)
+	}
+	if err := tr.spec.ValidateRequest(req.Method, specURL(req.Method, req.URL), body); err != nil {
+		if tr.mismatch != "" {
+			tr.t.Logf("known mismatch (%s):\n%v\nbody: %s", tr.mismatch, err, body)
+		} else {
+			tr.t.Errorf("request does not match the spec:\n%v\nbody: %s", err, body)
+		}
+	}
+
+	data := "{}"
+	if len(tr.responses) > 0 {
+		data, tr.responses = tr.responses[0], tr.responses[1:]
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"data":%s,"success":true,"error":false,"message":"ok","status":200}`, data))),
+		Request:    req,
+	}, nil
+}
+
+// contractPathExceptions are known mismatches between the client and the
+// bundled spec: requests whose path ends in the client suffix are sent to an
+// endpoint the spec documents under the spec suffix. They are validated
+// against the documented operation.
+var contractPathExceptions = []struct {
+	method, client, spec string
+}{
+	// Current Pangolin releases serve site resource creation as
+	// private-resource; the spec still documents site-resource.
+	{method: "PUT", client: "/private-resource", spec: "/site-resource"},
+}
+
+// specURL maps a request URL to the one the spec documents.
+func specURL(method string, u *url.URL) *url.URL {
+	for _, e := range contractPathExceptions {
+		if method == e.method && strings.HasSuffix(u.Path, e.client) {
+			mapped := *u
+			mapped.Path = strings.TrimSuffix(u.Path, e.client) + e.spec
+			return &mapped
+		}
+	}
+	return u
+}
+
+type contractCase struct {
+	// name is the Client method exercised, optionally followed by
+	// "/<variant>" when a method is covered by several cases.
+	name      string
+	call      func(ctx context.Context, c *Client) (any, error)
+	responses []string
+	want      any
+
+	// mismatch explains a known spec violation of the request, see
+	// contractTransport.
+	mismatch string
+}
+
+var contractCases = []contractCase{
+	// Roles
+	{
+		name: "CreateRole",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.CreateRole(ctx, "acme", &Role{Name: "Ops", Description: "Operators"})
+		},
+		responses: []string{`{"roleId":3,"orgId":"acme","isAdmin":false,"name":"Ops","description":"Operators"}`},
+		want:      &Role{ID: 3, OrgID: "acme", Name: "Ops", Description: "Operators"},
+	},
+	{
+		name:      "GetRole",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetRole(ctx, "acme", 1) },
+		responses: []string{`{"roleId":1,"orgId":"acme","isAdmin":true,"name":"Admin","description":"Admin role with the most permissions"}`},
+		want:      &Role{ID: 1, OrgID: "acme", Name: "Admin", Description: "Admin role with the most permissions", IsAdmin: true},
+	},
+	{
+		name: "UpdateRole",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.UpdateRole(ctx, "acme", 3, &Role{Name: "Ops", Description: "On call"})
+		},
+		responses: []string{`{"roleId":3,"orgId":"acme","isAdmin":false,"name":"Ops","description":"On call"}`},
+		want:      &Role{ID: 3, OrgID: "acme", Name: "Ops", Description: "On call"},
+	},
+	{
+		name: "DeleteRole",
+		call: func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteRole(ctx, "acme", 3) },
+		responses: []string{
+			`{"roles":[{"roleId":1,"orgId":"acme","isAdmin":true,"name":"Admin","description":"Admin"},{"roleId":7,"orgId":"acme","isAdmin":false,"name":"Member","description":"Members"}],"pagination":{"total":2,"limit":1000,"offset":0}}`,
+			`{}`,
+		},
+	},
+	{
+		name:      "ListRoles",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListRoles(ctx, "acme") },
+		responses: []string{`{"roles":[{"roleId":1,"orgId":"acme","isAdmin":true,"name":"Admin","description":"Admin"},{"roleId":2,"orgId":"acme","isAdmin":false,"name":"Member","description":"Members"}],"pagination":{"total":2,"limit":1000,"offset":0}}`},
+		want:      []Role{{ID: 1, OrgID: "acme", Name: "Admin", Description: "Admin", IsAdmin: true}, {ID: 2, OrgID: "acme", Name: "Member", Description: "Members"}},
+	},
+
+	// Users
+	{
+		name: "CreateOrgUser",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.CreateOrgUser(ctx, "acme", &OrgUser{Username: "jane", Email: "jane@example.com", Name: "Jane", Type: "oidc", IdpID: 1, RoleID: 2})
+		},
+		responses: []string{
+			`{}`,
+			`{"users":[{"id":"u-jane","email":"jane@example.com","emailVerified":true,"dateCreated":"2025-01-01T00:00:00.000Z","orgId":"acme","username":"jane","name":"Jane","type":"oidc","roleId":2,"roleName":"Member","isOwner":false,"idpName":"Corp","idpId":1,"twoFactorEnabled":false}],"pagination":{"total":1,"limit":1000,"offset":0}}`,
+		},
+		want: &OrgUser{ID: "u-jane", OrgID: "acme", Email: "jane@example.com", Username: "jane", Name: "Jane", Type: "oidc", IdpID: 1, RoleID: 2, RoleName: "Member"},
+	},
+	{
+		name:      "GetOrgUser",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetOrgUser(ctx, "acme", "u-jane") },
+		responses: []string{`{"orgId":"acme","userId":"u-jane","roleId":2,"isOwner":false,"email":"jane@example.com","name":"Jane","username":"jane","type":"oidc","idpId":1,"roleName":"Member","twoFactorEnabled":false}`},
+		want:      &OrgUser{ID: "u-jane", OrgID: "acme", Email: "jane@example.com", Username: "jane", Name: "Jane", Type: "oidc", IdpID: 1, RoleID: 2, RoleName: "Member"},
+	},
+	{
+		name:      "ListOrgUsers",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListOrgUsers(ctx, "acme") },
+		responses: []string{`{"users":[{"id":"u-owner","email":"owner@example.com","orgId":"acme","username":"owner","name":null,"type":"internal","roleId":1,"roleName":"Admin","isOwner":true,"idpId":null}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []OrgUser{{ID: "u-owner", OrgID: "acme", Email: "owner@example.com", Username: "owner", Type: "internal", RoleID: 1, RoleName: "Admin", IsOwner: true}},
+	},
+	{
+		name:      "CheckOrgUserAccess",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.CheckOrgUserAccess(ctx, "acme", "u-jane") },
+		responses: []string{`{"allowed":true}`},
+		want:      true,
+	},
+	{
+		name: "DeleteOrgUser",
+		call: func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteOrgUser(ctx, "acme", "u-jane") },
+	},
+	{
+		name: "AddRoleToUser",
+		call: func(ctx context.Context, c *Client) (any, error) { return nil, c.AddRoleToUser(ctx, 3, "u-jane") },
+	},
+	{
+		name:      "GetUser",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetUser(ctx, "u-jane") },
+		responses: []string{`{"userId":"u-jane","email":"jane@example.com","username":"jane","name":"Jane","type":"oidc","twoFactorEnabled":false,"twoFactorSetupRequested":true,"emailVerified":true,"serverAdmin":false,"idpId":1}`},
+		want:      &User{ID: "u-jane", Email: "jane@example.com", Username: "jane", Name: "Jane", Type: "oidc", TwoFactorSetupRequested: true},
+	},
+	{
+		name: "SetUserTwoFactorSetupRequested",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return nil, c.SetUserTwoFactorSetupRequested(ctx, "u-jane", true)
+		},
+	},
+
+	// Invitations
+	{
+		name: "CreateInvitation",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.CreateInvitation(ctx, "acme", "new@example.com", 2, 24, false)
+		},
+		responses: []string{
+			`{"inviteLink":"https://pangolin.example.com/invite?token=abc","expiresAt":1767225600000}`,
+			`{"invitations":[{"inviteId":"inv-1","email":"new@example.com","expiresAt":1767225000000,"roleId":2,"roleName":"Member"}],"pagination":{"total":1,"limit":1000,"offset":0}}`,
+		},
+		want: &Invitation{ID: "inv-1", Email: "new@example.com", RoleID: 2, ExpiresAt: 1767225600000, InviteLink: "https://pangolin.example.com/invite?token=abc"},
+	},
+	{
+		name:      "ListInvitations",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListInvitations(ctx, "acme") },
+		responses: []string{`{"invitations":[{"inviteId":"inv-1","email":"new@example.com","expiresAt":1767225000000,"roleId":2,"roleName":"Member"}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []Invitation{{ID: "inv-1", Email: "new@example.com", RoleID: 2, ExpiresAt: 1767225000000}},
+	},
+	{
+		name: "DeleteInvitation",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return nil, c.DeleteInvitation(ctx, "acme", "inv-1")
+		},
+	},
+
+	// Identity providers
+	{
+		name: "CreateOIDCIdp",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.CreateOIDCIdp(ctx, &OIDCIdp{
+				Name: "Corp", ClientID: "pangolin", ClientSecret: "s3cret",
+				AuthURL: "https://sso.example.com/auth", TokenURL: "https://sso.example.com/token",
+				IdentifierPath: "sub", EmailPath: "email", NamePath: "name", Scopes: "openid profile email", AutoProvision: true,
+			})
+		},
+		responses: []string{`{"idpId":4,"redirectUrl":"https://pangolin.example.com/auth/idp/4/oidc/callback"}`},
+		want:      &OIDCIdp{ID: 4, RedirectURL: "https://pangolin.example.com/auth/idp/4/oidc/callback"},
+	},
+	{
+		name:      "GetOIDCIdp",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetOIDCIdp(ctx, 4) },
+		responses: []string{`{"idp":{"idpId":4,"name":"Corp","type":"oidc","defaultRoleMapping":null,"defaultOrgMapping":null,"autoProvision":true},"idpOidcConfig":{"idpOauthConfigId":1,"idpId":4,"clientId":"pangolin","clientSecret":"s3cret","authUrl":"https://sso.example.com/auth","tokenUrl":"https://sso.example.com/token","identifierPath":"sub","emailPath":"email","namePath":"name","scopes":"openid profile email"},"redirectUrl":"https://pangolin.example.com/auth/idp/4/oidc/callback"}`},
+		want: &OIDCIdp{
+			ID: 4, Name: "Corp", ClientID: "pangolin",
+			AuthURL: "https://sso.example.com/auth", TokenURL: "https://sso.example.com/token",
+			IdentifierPath: "sub", EmailPath: "email", NamePath: "name", Scopes: "openid profile email", AutoProvision: true,
+			RedirectURL: "https://pangolin.example.com/auth/idp/4/oidc/callback",
+		},
+	},
+	{
+		name: "UpdateOIDCIdp",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return nil, c.UpdateOIDCIdp(ctx, 4, &OIDCIdp{
+				Name: "Corp", ClientID: "pangolin",
+				AuthURL: "https://sso.example.com/auth", TokenURL: "https://sso.example.com/token",
+				IdentifierPath: "sub", Scopes: "openid",
+			})
+		},
+	},
+	{
+		name: "DeleteIdp",
+		call: func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteIdp(ctx, 4) },
+	},
+	{
+		name: "CreateIdpOrgPolicy",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return nil, c.CreateIdpOrgPolicy(ctx, 4, "acme", &IdpOrgPolicy{RoleMapping: "'Member'", OrgMapping: "'acme'"})
+		},
+	},
+	{
+		name: "UpdateIdpOrgPolicy",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return nil, c.UpdateIdpOrgPolicy(ctx, 4, "acme", &IdpOrgPolicy{RoleMapping: "'Admin'"})
+		},
+	},
+	{
+		name: "DeleteIdpOrgPolicy",
+		call: func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteIdpOrgPolicy(ctx, 4, "acme") },
+	},
+	{
+		name:      "ListIdpOrgPolicies",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListIdpOrgPolicies(ctx, 4) },
+		responses: []string{`{"policies":[{"idpId":4,"orgId":"acme","roleMapping":"'Member'","orgMapping":"'acme'"}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []IdpOrgPolicy{{IdpID: 4, OrgID: "acme", RoleMapping: "'Member'", OrgMapping: "'acme'"}},
+	},
+
+	// API keys
+	{
+		name:      "CreateAPIKey",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.CreateAPIKey(ctx, "acme", "ci") },
+		responses: []string{`{"apiKeyId":"key-1","name":"ci","key":"key-1.secret","lastChars":"cret","createdAt":"2025-01-01T00:00:00.000Z"}`},
+		want:      &APIKey{ID: "key-1", Name: "ci", Key: "key-1.secret", LastChars: "cret", CreatedAt: "2025-01-01T00:00:00.000Z"},
+	},
+	{
+		name:      "ListAPIKeys",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListAPIKeys(ctx, "acme") },
+		responses: []string{`{"apiKeys":[{"apiKeyId":"key-1","orgId":"acme","lastChars":"cret","createdAt":"2025-01-01T00:00:00.000Z","name":"ci"}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []APIKey{{ID: "key-1", Name: "ci", LastChars: "cret", CreatedAt: "2025-01-01T00:00:00.000Z"}},
+	},
+	{
+		name: "DeleteAPIKey",
+		call: func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteAPIKey(ctx, "acme", "key-1") },
+	},
+	{
+		name:      "GetAPIKeyActions",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetAPIKeyActions(ctx, "acme", "key-1") },
+		responses: []string{`{"actions":[{"actionId":"getSite"},{"actionId":"listSites"}],"pagination":{"total":2,"limit":1000,"offset":0}}`},
+		want:      []string{"getSite", "listSites"},
+	},
+	{
+		name: "SetAPIKeyActions",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return nil, c.SetAPIKeyActions(ctx, "acme", "key-1", []string{"getSite"})
+		},
+	},
+
+	// Blueprints
+	{
+		name: "ApplyBlueprint",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.ApplyBlueprint(ctx, "acme", []byte(`{"resources":{}}`))
+		},
+		responses: []string{`{"blueprintId":9,"orgId":"acme","name":"Sunny Blueprint","source":"API","succeeded":true,"contents":"{\"resources\":{}}","message":"","createdAt":1735689600}`},
+		want:      &Blueprint{ID: 9, Name: "Sunny Blueprint", Source: "API", Succeeded: true, Contents: `{"resources":{}}`},
+	},
+	{
+		name:      "GetBlueprint",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetBlueprint(ctx, "acme", 9) },
+		responses: []string{`{"blueprintId":9,"orgId":"acme","name":"Sunny Blueprint","source":"API","succeeded":false,"contents":"{}","message":"invalid","createdAt":1735689600}`},
+		want:      &Blueprint{ID: 9, Name: "Sunny Blueprint", Source: "API", Contents: "{}", Message: "invalid"},
+	},
+	{
+		name:      "ListBlueprints",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListBlueprints(ctx, "acme") },
+		responses: []string{`{"blueprints":[{"blueprintId":9,"orgId":"acme","name":"Sunny Blueprint","source":"UI","succeeded":true,"message":null,"createdAt":1735689600}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []Blueprint{{ID: 9, Name: "Sunny Blueprint", Source: "UI", Succeeded: true}},
+	},
+
+	// Domains
+	{
+		name:      "ListDomains",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListDomains(ctx, "acme") },
+		responses: []string{`{"domains":[{"domainId":"local","baseDomain":"example.com","verified":true,"type":"wildcard","failed":false,"tries":0,"configManaged":true,"certResolver":null,"preferWildcardCert":null}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []Domain{{ID: "local", BaseDomain: "example.com", Type: "wildcard", Verified: true, ConfigManaged: true}},
+	},
+	{
+		name:      "GetDomain",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetDomain(ctx, "acme", "local") },
+		responses: []string{`{"domainId":"local","baseDomain":"example.com","verified":true,"type":"wildcard","failed":false,"configManaged":false,"certResolver":"letsencrypt","preferWildcardCert":true}`},
+		want:      &Domain{ID: "local", BaseDomain: "example.com", Type: "wildcard", Verified: true, CertResolver: ptr("letsencrypt"), PreferWildcardCert: ptr(true)},
+	},
+	{
+		name:      "GetDomainDNSRecords",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetDomainDNSRecords(ctx, "acme", "local") },
+		responses: []string{`[{"id":1,"domainId":"local","recordType":"CNAME","baseDomain":"*.example.com","value":"pangolin.example.net","verified":false}]`},
+		want:      []DNSRecord{{ID: 1, RecordType: "CNAME", BaseDomain: "*.example.com", Value: "pangolin.example.net"}},
+	},
+
+	// Sites
+	{
+		name:      "GetSite",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetSite(ctx, 1) },
+		responses: []string{`{"siteId":1,"orgId":"acme","niceId":"sunny-site","exitNodeId":1,"name":"Home","pubKey":null,"subnet":"100.89.128.4/30","megabytesIn":1.5,"megabytesOut":0.25,"lastBandwidthUpdate":"2025-01-01T00:00:00.000Z","type":"newt","online":true,"address":"100.89.128.1","dockerSocketEnabled":true}`},
+		want: &Site{
+			ID: 1, NiceID: "sunny-site", OrgID: "acme", Name: "Home", Type: "newt", Online: true,
+			Subnet: ptr("100.89.128.4/30"), Address: ptr("100.89.128.1"), MegabytesIn: 1.5, MegabytesOut: 0.25,
+			LastBandwidthUpdate: ptr("2025-01-01T00:00:00.000Z"), DockerSocketEnabled: true,
+		},
+	},
+	{
+		name:      "GetSiteByNiceID",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetSiteByNiceID(ctx, "acme", "sunny-site") },
+		responses: []string{`{"siteId":1,"orgId":"acme","niceId":"sunny-site","name":"Home","type":"wireguard","online":false,"subnet":null,"address":null}`},
+		want:      &Site{ID: 1, NiceID: "sunny-site", OrgID: "acme", Name: "Home", Type: "wireguard"},
+	},
+	{
+		name:      "ListSites",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListSites(ctx, "acme") },
+		responses: []string{`{"sites":[{"siteId":1,"niceId":"sunny-site","name":"Home","pubKey":null,"subnet":"100.89.128.4/30","megabytesIn":0,"megabytesOut":0,"orgName":"Acme","type":"newt","online":true,"address":null,"newtVersion":"1.5.0"}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []Site{{ID: 1, NiceID: "sunny-site", Name: "Home", Type: "newt", Online: true, Subnet: ptr("100.89.128.4/30")}},
+	},
+	{
+		name:      "CreateSite",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.CreateSite(ctx, "acme", "Home") },
+		responses: []string{`{"siteId":2,"orgId":"acme","niceId":"cloudy-site","name":"Home","type":"newt","online":false,"subnet":"100.89.128.8/30","address":null}`},
+		want:      &Site{ID: 2, NiceID: "cloudy-site", OrgID: "acme", Name: "Home", Type: "newt", Subnet: ptr("100.89.128.8/30")},
+	},
+
+	// Site resources
+	{
+		name: "CreateSiteResource",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.CreateSiteResource(ctx, "acme", &SiteResource{
+				Name: "DB", Mode: "host", SiteID: 1, Destination: "10.0.0.5", Enabled: true,
+				Alias: ptr("db.internal"), UserIDs: []string{}, RoleIDs: []int{2}, ClientIDs: []int{},
+			})
+		},
+		responses: []string{`{"siteResourceId":5,"siteId":1,"niceId":"quiet-db","name":"DB","mode":"host","destination":"10.0.0.5","enabled":true,"alias":"db.internal","orgId":"acme","tcpPortRangeString":"*","udpPortRangeString":"*","disableIcmp":false}`},
+		want: &SiteResource{
+			ID: 5, NiceID: "quiet-db", Name: "DB", Mode: "host", SiteID: 1, Destination: "10.0.0.5", Enabled: true,
+			Alias: ptr("db.internal"), TCPPortRangeString: "*", UDPPortRangeString: "*",
+		},
+	},
+	{
+		name:      "GetSiteResource",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetSiteResource(ctx, "acme", 1, 5) },
+		responses: []string{`{"siteResourceId":5,"siteId":1,"niceId":"quiet-db","name":"DB","mode":"cidr","destination":"10.0.0.0/24","enabled":false,"alias":null,"orgId":"acme","tcpPortRangeString":"5432","udpPortRangeString":"","disableIcmp":true}`},
+		want:      &SiteResource{ID: 5, NiceID: "quiet-db", Name: "DB", Mode: "cidr", SiteID: 1, Destination: "10.0.0.0/24", TCPPortRangeString: "5432", DisableIcmp: true},
+	},
+	{
+		name: "UpdateSiteResource",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.UpdateSiteResource(ctx, 5, &SiteResource{
+				Name: "DB", Mode: "host", SiteID: 1, Destination: "10.0.0.6", Enabled: true,
+				UserIDs: []string{"u-jane"}, RoleIDs: []int{}, ClientIDs: []int{7},
+			})
+		},
+		responses: []string{`{"siteResourceId":5,"siteId":1,"niceId":"quiet-db","name":"DB","mode":"host","destination":"10.0.0.6","enabled":true,"alias":null}`},
+		want:      &SiteResource{ID: 5, NiceID: "quiet-db", Name: "DB", Mode: "host", SiteID: 1, Destination: "10.0.0.6", Enabled: true},
+	},
+	{
+		name: "DeleteSiteResource",
+		call: func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteSiteResource(ctx, 5) },
+	},
+	{
+		name: "GetSiteResourceByNiceID",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.GetSiteResourceByNiceID(ctx, "acme", 1, "quiet-db")
+		},
+		responses: []string{`{"siteResourceId":5,"siteId":1,"orgId":"acme","niceId":"quiet-db","name":"DB","mode":"host","destination":"10.0.0.5","enabled":true,"alias":null}`},
+		want:      &SiteResource{ID: 5, NiceID: "quiet-db", Name: "DB", Mode: "host", SiteID: 1, Destination: "10.0.0.5", Enabled: true},
+	},
+	{
+		name:      "ListSiteResources",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListSiteResources(ctx, "acme") },
+		responses: []string{`{"siteResources":[{"siteResourceId":5,"siteId":1,"siteName":"Home","siteNiceId":"sunny-site","niceId":"quiet-db","name":"DB","mode":"host","destination":"10.0.0.5","enabled":true,"alias":null}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []SiteResource{{ID: 5, NiceID: "quiet-db", Name: "DB", Mode: "host", SiteID: 1, Destination: "10.0.0.5", Enabled: true}},
+	},
+	{
+		name:      "ListSiteResourcesBySite",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListSiteResourcesBySite(ctx, "acme", 1) },
+		responses: []string{`{"siteResources":[{"siteResourceId":5,"siteId":1,"niceId":"quiet-db","name":"DB","mode":"host","destination":"10.0.0.5","enabled":true}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []SiteResource{{ID: 5, NiceID: "quiet-db", Name: "DB", Mode: "host", SiteID: 1, Destination: "10.0.0.5", Enabled: true}},
+	},
+	{
+		name:      "GetSiteResourceRoles",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetSiteResourceRoles(ctx, 5) },
+		responses: []string{`{"roles":[{"roleId":1,"name":"Admin","description":"Admin","isAdmin":true},{"roleId":2,"name":"Member","description":"Members","isAdmin":false},{"roleId":1,"name":"Admin","description":"Admin","isAdmin":true}]}`},
+		want:      []int{1, 2},
+	},
+	{
+		name:      "GetSiteResourceUsers",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetSiteResourceUsers(ctx, 5) },
+		responses: []string{`{"users":[{"userId":"u-jane","email":"jane@example.com"}]}`},
+		want:      []string{"u-jane"},
+	},
+	{
+		name:      "GetSiteResourceClients",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetSiteResourceClients(ctx, 5) },
+		responses: []string{`{"clients":[{"clientId":7,"name":"laptop","subnet":"100.90.128.2/32"}]}`},
+		want:      []int{7},
+	},
+
+	// Resources
+	{
+		name: "CreateResource/http",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.CreateResource(ctx, "acme", &Resource{Name: "App", Protocol: "tcp", Http: true, Subdomain: "app", DomainID: "local"})
+		},
+		responses: []string{`{"resourceId":3,"niceId":"bright-app","orgId":"acme","name":"App","subdomain":"app","fullDomain":"app.example.com","domainId":"local","ssl":true,"blockAccess":false,"sso":true,"http":true,"protocol":"tcp","proxyPort":null,"emailWhitelistEnabled":false,"applyRules":false,"enabled":true,"stickySession":false,"tlsServerName":null,"setHostHeader":null,"enableProxy":true}`},
+		want:      &Resource{ID: 3, Name: "App", Protocol: "tcp", Http: true, Subdomain: "app", DomainID: "local", NiceID: "bright-app", OrgID: "acme", FullDomain: "app.example.com", Enabled: ptr(true), SSL: ptr(true), SSO: ptr(true)},
+	},
+	{
+		name: "CreateResource/raw",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.CreateResource(ctx, "acme", &Resource{Name: "SSH", Protocol: "tcp", ProxyPort: ptr(2222)})
+		},
+		responses: []string{`{"resourceId":4,"niceId":"calm-ssh","orgId":"acme","name":"SSH","subdomain":null,"fullDomain":null,"domainId":null,"ssl":false,"sso":false,"http":false,"protocol":"tcp","proxyPort":2222,"enabled":true}`},
+		want:      &Resource{ID: 4, Name: "SSH", Protocol: "tcp", NiceID: "calm-ssh", OrgID: "acme", ProxyPort: ptr(2222), Enabled: ptr(true), SSL: ptr(false), SSO: ptr(false)},
+		mismatch:  "the whole resource is sent, including subdomain and domainId of raw resources",
+	},
+	{
+		name:      "GetResource",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetResource(ctx, 3) },
+		responses: []string{`{"resourceId":3,"niceId":"bright-app","orgId":"acme","name":"App","subdomain":"app","fullDomain":"app.example.com","domainId":"local","ssl":true,"sso":false,"http":true,"protocol":"tcp","proxyPort":null,"enabled":false}`},
+		want:      &Resource{ID: 3, Name: "App", Protocol: "tcp", Http: true, Subdomain: "app", DomainID: "local", NiceID: "bright-app", OrgID: "acme", FullDomain: "app.example.com", Enabled: ptr(false), SSL: ptr(true), SSO: ptr(false)},
+	},
+	{
+		name: "GetResourceByNiceID",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.GetResourceByNiceID(ctx, "acme", "bright-app")
+		},
+		responses: []string{`{"resourceId":3,"niceId":"bright-app","orgId":"acme","name":"App","subdomain":"app","fullDomain":"app.example.com","domainId":"local","http":true,"protocol":"tcp"}`},
+		want:      &Resource{ID: 3, Name: "App", Protocol: "tcp", Http: true, Subdomain: "app", DomainID: "local", NiceID: "bright-app", OrgID: "acme", FullDomain: "app.example.com"},
+	},
+	{
+		name: "UpdateResource/http",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.UpdateResource(ctx, 3, &Resource{Name: "App", Protocol: "tcp", Http: true, Subdomain: "app2", DomainID: "local"})
+		},
+		responses: []string{`{"resourceId":3,"niceId":"bright-app","orgId":"acme","name":"App","subdomain":"app2","fullDomain":"app2.example.com","domainId":"local","http":true,"protocol":"tcp"}`},
+		want:      &Resource{ID: 3, Name: "App", Protocol: "tcp", Http: true, Subdomain: "app2", DomainID: "local", NiceID: "bright-app", OrgID: "acme", FullDomain: "app2.example.com"},
+		mismatch:  "the whole resource is sent, including protocol and http, which cannot be updated",
+	},
+	{
+		name: "UpdateResource/raw",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.UpdateResource(ctx, 4, &Resource{Name: "SSH", Protocol: "tcp", ProxyPort: ptr(2200)})
+		},
+		responses: []string{`{"resourceId":4,"niceId":"calm-ssh","orgId":"acme","name":"SSH","http":false,"protocol":"tcp","proxyPort":2200}`},
+		want:      &Resource{ID: 4, Name: "SSH", Protocol: "tcp", NiceID: "calm-ssh", OrgID: "acme", ProxyPort: ptr(2200)},
+		mismatch:  "the whole resource is sent, including protocol and http, which cannot be updated",
+	},
+	{
+		name:      "GetResourceUsers",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetResourceUsers(ctx, 3) },
+		responses: []string{`{"users":[{"userId":"u-jane","email":"jane@example.com"}]}`},
+		want:      []string{"u-jane"},
+	},
+	{
+		name:      "GetResourceRoles",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetResourceRoles(ctx, 3) },
+		responses: []string{`{"roles":[{"roleId":1,"name":"Admin","description":"Admin","isAdmin":true}]}`},
+		want:      []int{1},
+	},
+	{
+		name:      "ListResources",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListResources(ctx, "acme") },
+		responses: []string{`{"resources":[{"resourceId":3,"niceId":"bright-app","name":"App","ssl":true,"fullDomain":"app.example.com","passwordId":null,"sso":true,"pincodeId":4,"whitelist":true,"http":true,"protocol":"tcp","proxyPort":null,"enabled":true,"domainId":"local","headerAuthId":null,"targets":[]}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []Resource{{ID: 3, Name: "App", Protocol: "tcp", Http: true, DomainID: "local", NiceID: "bright-app", FullDomain: "app.example.com", Enabled: ptr(true), SSL: ptr(true), SSO: ptr(true), PincodeID: ptr(4), Whitelist: true}},
+	},
+	{
+		name: "DeleteResource",
+		call: func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteResource(ctx, 3) },
+	},
+
+	// Targets
+	{
+		name: "CreateTarget",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.CreateTarget(ctx, 3, &Target{SiteID: 1, IP: "10.0.0.5", Port: 8080, Enabled: true})
+		},
+		responses: []string{`{"targetId":11,"resourceId":3,"siteId":1,"ip":"10.0.0.5","method":"http","port":8080,"internalPort":null,"enabled":true,"path":null,"pathMatchType":null,"rewritePath":null,"rewritePathType":null,"priority":100}`},
+		want:      &Target{ID: 11, SiteID: 1, IP: "10.0.0.5", Port: 8080, Method: ptr("http"), Enabled: true, Priority: ptr(100)},
+	},
+	{
+		name:      "ListTargets",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.ListTargets(ctx, 3) },
+		responses: []string{`{"targets":[{"targetId":11,"resourceId":3,"siteId":1,"ip":"10.0.0.5","method":null,"port":8080,"enabled":true,"hcEnabled":true,"hcPath":"/health","hcInterval":30,"hcHeaders":null,"priority":100}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want:      []Target{{ID: 11, SiteID: 1, IP: "10.0.0.5", Port: 8080, Enabled: true, HCEnabled: ptr(true), HCPath: ptr("/health"), HCInterval: ptr(30), Priority: ptr(100)}},
+	},
+	{
+		name:      "GetTarget",
+		call:      func(ctx context.Context, c *Client) (any, error) { return c.GetTarget(ctx, 11) },
+		responses: []string{`{"targetId":11,"resourceId":3,"siteId":1,"ip":"10.0.0.5","method":"https","port":8443,"enabled":false,"hcHeaders":[{"name":"Host","value":"app.internal"}]}`},
+		want:      &Target{ID: 11, SiteID: 1, IP: "10.0.0.5", Port: 8443, Method: ptr("https"), HCHeaders: []TargetHeader{{Name: "Host", Value: "app.internal"}}},
+	},
+	{
+		name: "UpdateTarget",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.UpdateTarget(ctx, 11, &Target{SiteID: 1, IP: "10.0.0.6", Port: 8080, Enabled: true})
+		},
+		responses: []string{`{"targetId":11,"resourceId":3,"siteId":1,"ip":"10.0.0.6","port":8080,"enabled":true}`},
+		want:      &Target{ID: 11, SiteID: 1, IP: "10.0.0.6", Port: 8080, Enabled: true},
+	},
+	{
+		name: "DeleteTarget",
+		call: func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteTarget(ctx, 11) },
+	},
+
+	// Logs
+	{
+		name: "ListRequestLogs",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			blocked := false
+			return c.ListRequestLogs(ctx, "acme", RequestLogQuery{
+				TimeStart: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Action: &blocked, ResourceID: 3,
+				Method: "GET", Location: "DE", Host: "app.example.com", Path: "/admin", Actor: "jane",
+			}, 0)
+		},
+		responses: []string{`{"log":[{"id":21,"timestamp":1735689600,"orgId":"acme","action":false,"reason":201,"actorType":"user","actor":"jane","actorId":"u-jane","resourceId":3,"ip":"203.0.113.9","location":"DE","userAgent":"curl/8","host":"app.example.com","path":"/admin","method":"GET","tls":true}],"pagination":{"total":1,"limit":1000,"offset":0}}`},
+		want: []RequestLog{{
+			ID: 21, Timestamp: 1735689600, Reason: 201, ActorType: ptr("user"), Actor: ptr("jane"), ResourceID: ptr(3),
+			IP: ptr("203.0.113.9"), Location: ptr("DE"), Method: ptr("GET"), Host: ptr("app.example.com"), Path: ptr("/admin"),
+		}},
+	},
+	{
+		name: "GetRequestAnalytics",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.GetRequestAnalytics(ctx, "acme", RequestLogQuery{TimeEnd: time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC), ResourceID: 3, Host: "ignored"})
+		},
+		responses: []string{`{"requestsPerCountry":[{"code":"DE","count":4}],"requestsPerDay":[{"day":"2025-01-07","allowedCount":3,"blockedCount":1,"totalCount":4}],"totalBlocked":1,"totalRequests":4}`},
+		want: &RequestAnalytics{
+			TotalRequests: 4, TotalBlocked: 1,
+			RequestsPerCountry: []CountryRequestCount{{Code: "DE", Count: 4}},
+			RequestsPerDay:     []DailyRequestCount{{Day: "2025-01-07", AllowedCount: 3, BlockedCount: 1, TotalCount: 4}},
+		},
+	},
+
+	// Generated operations
+	{
+		name: "Ping",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return nil, c.Ping(ctx)
+		},
+		responses: []string{`"Healthy"`},
+	},
+	{
+		name: "CheckToken/org",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			c.OrgID = "acme"
+			return nil, c.CheckToken(ctx)
+		},
+		responses: []string{`{"org":{"orgId":"acme","name":"Acme"}}`},
+	},
+	{
+		name: "CheckToken/orgs",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return nil, c.CheckToken(ctx)
+		},
+		responses: []string{`{"orgs":[{"orgId":"acme","name":"Acme"}],"pagination":{"total":1,"limit":1,"offset":0}}`},
+	},
+	{
+		name: "API/GetRoot",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			data, err := c.API().GetRoot(ctx)
+			return string(data), err
+		},
+		responses: []string{`"Healthy"`},
+		want:      `"Healthy"`,
+	},
+	{
+		name: "API/PutResourceRule",
+		call: func(ctx context.Context, c *Client) (any, error) {
+			return c.API().PutResourceRule(ctx, 7, &PutResourceRuleBody{
+				Action:   RuleActionDrop,
+				Match:    RuleMatchCIDR,
+				Value:    "10.0.0.0/8",
+				Priority: 1,
+				Enabled:  ptr(true),
+			})
+		},
+	},
+}
+
+func TestContract(t *testing.T) {
+	spec := loadContractSpec(t)
+
+	for _, tc := range contractCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tr := &contractTransport{t: t, spec: spec, responses: tc.responses, mismatch: tc.mismatch}
+			c := NewClient("https://pangolin.example.com"+spec.BasePath(), "contract-token")
+			c.HTTPClient.Transport = tr
+
+			got, err := tc.call(t.Context(), c)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(tr.requests) == 0 {
+				t.Fatal("no request was sent")
+			}
+			if len(tr.responses) > 0 {
+				t.Errorf("%d queued responses were not requested; requests: %v", len(tr.responses), tr.requests)
+			}
+			if tc.want != nil && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("decoded response mismatch\n got: %s\nwant: %s", dump(got), dump(tc.want))
+			}
+		})
+	}
+}
+
+// TestContractPathExceptions checks that the known mismatches are still sent
+// to the client path, so an exception is dropped once the client follows the
+// spec again.
+func TestContractPathExceptions(t *testing.T) {
+	spec := loadContractSpec(t)
+	tr := &contractTransport{t: t, spec: spec}
+	c := NewClient("https://pangolin.example.com"+spec.BasePath(), "contract-token")
+	c.HTTPClient.Transport = tr
+
+	if _, err := c.CreateSiteResource(t.Context(), "acme", &SiteResource{
+		Name: "DB", Mode: "host", SiteID: 1, Destination: "10.0.0.5", UserIDs: []string{}, RoleIDs: []int{}, ClientIDs: []int{},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "PUT " + spec.BasePath() + "/org/acme/private-resource"; len(tr.requests) != 1 || tr.requests[0] != want {
+		t.Errorf("got requests %v, want [%s]", tr.requests, want)
+	}
+}
+
+// TestContractCoversClient fails when a Client method has no contract case,
+// so new endpoints are checked against the spec as they are added.
+func TestContractCoversClient(t *testing.T) {
+	covered := map[string]bool{}
+	for _, tc := range contractCases {
+		name, _, _ := strings.Cut(tc.name, "/")
+		covered[name] = true
+	}
+	typ := reflect.TypeOf(&Client{})
+	for i := 0; i < typ.NumMethod(); i++ {
+		if name := typ.Method(i).Name; !covered[name] {
+			t.Errorf("Client.%s has no contract case", name)
+		}
+	}
+}
+
+func dump(v any) string {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		return fmt.Sprintf("&%+v", rv.Elem().Interface())
+	}
+	return fmt.Sprintf("%+v", v)
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter bounds the requests of a Client: a token bucket limits their rate
+// and a semaphore the number in flight. A nil *Limiter limits nothing.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second; 0 means unlimited
+	burst  float64
+	tokens float64
+	last   time.Time
+	slots  chan struct{} // nil means unlimited
+
+	waits     int64
+	totalWait time.Duration
+}
+
+// LimiterWait is the time a request spent waiting for the Limiter.
+type LimiterWait struct {
+	Rate        time.Duration // waiting for a token of the rate limit
+	Concurrency time.Duration // waiting for a request in flight to finish
+}
+
+// Total returns the whole time spent waiting.
+func (w LimiterWait) Total() time.Duration {
+	return w.Rate + w.Concurrency
+}
+
+// NewLimiter returns a Limiter allowing requestsPerSecond requests per second,
+// in bursts of up to one second's worth, and maxConcurrent requests in
+// flight. Zero disables either limit.
+func NewLimiter(requestsPerSecond float64, maxConcurrent int) *Limiter {
+	l := &Limiter{rate: requestsPerSecond}
+	if requestsPerSecond > 0 {
+		l.burst = math.Max(1, requestsPerSecond)
+		l.tokens = l.burst
+		l.last = time.Now()
+	}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	return l
+}
+
+// Acquire waits until a request may be sent. The request must call release
+// once it is done. It returns ctx's error if ctx ends first.
+func (l *Limiter) Acquire(ctx context.Context) (release func(), wait LimiterWait, err error) {
+	if l == nil {
+		return func() {}, wait, nil
+	}
+
+	if wait.Rate, err = l.reserve(ctx); err != nil {
+		return nil, wait, err
+	}
+
+	release = func() {}
+	if l.slots != nil {
+		start := time.Now()
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, wait, ctx.Err()
+		}
+		wait.Concurrency = time.Since(start)
+		release = func() { <-l.slots }
+	}
+
+	if wait.Total() > 0 {
+		l.mu.Lock()
+		l.waits++
+		l.totalWait += wait.Total()
+		l.mu.Unlock()
+	}
+	return release, wait, nil
+}
+
+// reserve takes a token from the bucket, waiting for it when it is empty.
+func (l *Limiter) reserve(ctx context.Context) (time.Duration, error) {
+	if l.rate <= 0 {
+		return 0, nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return 0, nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		// Hand back the token for the requests queued behind this one.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return 0, ctx.Err()
+	}
+}
+
+// Stats returns how many requests had to wait, and for how long in total.
+func (l *Limiter) Stats() (waits int64, total time.Duration) {
+	if l == nil {
+		return 0, 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.waits, l.totalWait
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLimiter_Rate(t *testing.T) {
+	// A burst of 20, then one token every 50ms.
+	l := NewLimiter(20, 0)
+	start := time.Now()
+	var waited time.Duration
+	for i := 0; i < 25; i++ {
+		release, wait, err := l.Acquire(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+		waited += wait.Rate
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("25 requests at 20/s took %v, want at least 250ms", elapsed)
+	}
+	if waits, total := l.Stats(); waits != 5 || total != waited {
+		t.Errorf("Stats() = %d, %v, want 5 waits for %v", waits, total, waited)
+	}
+}
+
+func TestLimiter_Concurrency(t *testing.T) {
+	l := NewLimiter(0, 2)
+	var inFlight, peak atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, _, err := l.Acquire(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer release()
+			n := inFlight.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			inFlight.Add(-1)
+		}()
+	}
+	wg.Wait()
+	if peak.Load() != 2 {
+		t.Errorf("peak of %d requests in flight, want 2", peak.Load())
+	}
+}
+
+func TestLimiter_Canceled(t *testing.T) {
+	l := NewLimiter(1, 1)
+	release, _, err := l.Acquire(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+	if _, _, err := l.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline to end the wait, got %v", err)
+	}
+}
+
+func TestDoRequestLimiterLogging(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":true,"data":{"roles":[]}}`)
+	}))
+	defer srv.Close()
+
+	t.Setenv("TF_LOG_PROVIDER_PANGOLIN", "DEBUG")
+	var out bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &out)
+
+	c := NewClient(srv.URL, "token")
+	c.Limiter = NewLimiter(50, 1)
+	for i := 0; i < 60; i++ {
+		if _, err := c.ListRoles(ctx, "acme"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := entries[len(entries)-1]
+	for _, key := range []string{"rate_limit_wait_ms", "concurrency_wait_ms", "limiter_waits", "limiter_wait_total_ms"} {
+		if _, ok := last[key]; !ok {
+			t.Errorf("log entry lacks %s: %v", key, last)
+		}
+	}
+	if waits, _ := last["limiter_waits"].(float64); waits == 0 {
+		t.Errorf("expected requests beyond the burst to wait: %v", last)
+	}
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem the client logs to. Its level follows
+// TF_LOG_PROVIDER_PANGOLIN, and the provider's log level when that is unset.
+const logSubsystem = "pangolin_client"
+
+// masked replaces sensitive values in logged bodies.
+const masked = "***"
+
+// sensitiveKeys are the JSON properties, in lower case, whose values are
+// masked in logged request and response bodies: passwords, PIN codes, the
+// newt and OLM secrets of sites and clients, OIDC client secrets, API keys,
+// access tokens and invitation links, which carry the invitation token.
+var sensitiveKeys = map[string]bool{
+	"password":     true,
+	"pincode":      true,
+	"secret":       true,
+	"newtsecret":   true,
+	"olmsecret":    true,
+	"clientsecret": true,
+	"key":          true,
+	"token":        true,
+	"accesstoken":  true,
+	"invitelink":   true,
+}
+
+// logContext adds the client's log subsystem to ctx, masking the bearer token
+// in every field it logs.
+func (c *Client) logContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_PANGOLIN"))
+	if c.Token != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, logSubsystem, c.Token)
+	}
+	return ctx
+}
+
+// maskBody returns a JSON body for logging with the values of sensitiveKeys
+// masked at any depth. Bodies that are not JSON are returned unchanged.
+func maskBody(body []byte) string {
+	out, _ := replaceSensitive(body, masked)
+	return out
+}
+
+// replaceSensitive returns body with the values of sensitiveKeys replaced by
+// with at any depth, and whether any value was replaced. Bodies that are not
+// JSON are returned unchanged.
+func replaceSensitive(body []byte, with string) (string, bool) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return string(body), false
+	}
+	replaced := false
+	out, err := json.Marshal(maskValue(v, with, &replaced))
+	if err != nil {
+		return string(body), false
+	}
+	return string(out), replaced
+}
+
+func maskValue(v interface{}, with string, replaced *bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if sensitiveKeys[strings.ToLower(k)] && val != nil {
+				v[k] = with
+				*replaced = true
+				continue
+			}
+			v[k] = maskValue(val, with, replaced)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = maskValue(val, with, replaced)
+		}
+	}
+	return v
+}
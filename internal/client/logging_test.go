@@ -0,0 +1,117 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestDoRequestLogging(t *testing.T) {
+	const token = "log-token"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"success":true,"message":"ok","data":{"apiKeyId":"k1","key":"plaintext-key","echo":%q}}`, r.Header.Get("Authorization"))
+	}))
+	defer srv.Close()
+
+	t.Setenv("TF_LOG_PROVIDER_PANGOLIN", "TRACE")
+	var out bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &out)
+
+	c := NewClient(srv.URL, token)
+	body := map[string]interface{}{
+		"name":    "site",
+		"secret":  "newt-secret",
+		"headers": []interface{}{map[string]interface{}{"password": "hunter2", "pincode": "123456"}},
+	}
+	if _, err := c.doRequest(ctx, "PUT", "/org/acme/site", body); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 log entries, got %d: %v", len(entries), entries)
+	}
+	summary := entries[1]
+	if summary["@level"] != "debug" || summary["@module"] != "provider."+logSubsystem {
+		t.Errorf("unexpected summary entry %v", summary)
+	}
+	for _, key := range []string{"method", "path", "status", "latency_ms"} {
+		if _, ok := summary[key]; !ok {
+			t.Errorf("summary entry lacks %s: %v", key, summary)
+		}
+	}
+	if entries[0]["@level"] != "trace" || entries[2]["@level"] != "trace" {
+		t.Errorf("bodies should be logged at trace: %v", entries)
+	}
+
+	logged := fmt.Sprint(entries)
+	for _, secret := range []string{token, "newt-secret", "hunter2", "123456", "plaintext-key"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("log contains %q: %s", secret, logged)
+		}
+	}
+}
+
+func TestDoRequestLogging_InviteLink(t *testing.T) {
+	const link = "https://pangolin.example.com/invite?token=invite-token"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"success":true,"message":"ok","data":{"inviteLink":%q,"expiresAt":1767225600000}}`, link)
+	}))
+	defer srv.Close()
+
+	t.Setenv("TF_LOG_PROVIDER_PANGOLIN", "TRACE")
+	var out bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &out)
+
+	if _, err := NewClient(srv.URL, "token").doRequest(ctx, "POST", "/org/acme/create-invite", map[string]interface{}{"email": "new@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	logged := out.String()
+	if strings.Contains(logged, "invite-token") {
+		t.Errorf("log contains the invitation token: %s", logged)
+	}
+	if !strings.Contains(logged, "1767225600000") {
+		t.Errorf("log lacks the response body: %s", logged)
+	}
+}
+
+func TestDoRequestLoggingLevel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":true,"data":{}}`)
+	}))
+	defer srv.Close()
+
+	t.Setenv("TF_LOG_PROVIDER_PANGOLIN", "DEBUG")
+	var out bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &out)
+
+	if _, err := NewClient(srv.URL, "token").doRequest(ctx, "PUT", "/org/acme/role", map[string]string{"name": "x"}); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := tflogtest.MultilineJSONDecode(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0]["@level"] != "debug" {
+		t.Errorf("expected only the debug summary, got %v", entries)
+	}
+}
+
+func TestMaskBody(t *testing.T) {
+	got := maskBody([]byte(`{"name":"a","Secret":"s","clientSecret":null,"nested":[{"olmSecret":"o"}]}`))
+	want := `{"Secret":"***","clientSecret":null,"name":"a","nested":[{"olmSecret":"***"}]}`
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got := maskBody([]byte("not json")); got != "not json" {
+		t.Errorf("non-JSON body changed: %s", got)
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNotIntegrationAPI means the base URL answered, but not like the
+	// Pangolin Integration API, e.g. because it points to the dashboard.
+	ErrNotIntegrationAPI = errors.New("not a Pangolin Integration API")
+	// ErrInvalidToken means the API rejected the token.
+	ErrInvalidToken = errors.New("the API token is invalid")
+)
+
+// Ping calls the health endpoint. It returns an error wrapping
+// ErrNotIntegrationAPI or ErrInvalidToken when the answer says so. The
+// endpoint is public, so a successful Ping does not prove the token valid;
+// use CheckToken for that.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.API().GetRoot(ctx)
+	var syntaxErr *json.SyntaxError
+	switch {
+	case IsNotFound(err) || errors.As(err, &syntaxErr):
+		return fmt.Errorf("%w: %w", ErrNotIntegrationAPI, err)
+	case IsUnauthorized(err):
+		return fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	return err
+}
+
+// CheckToken calls an endpoint that requires authentication: the default
+// organization, or the list of organizations when none is set. It returns an
+// error wrapping ErrInvalidToken when the API rejects the token. A 403 means
+// the token is valid but may not read that endpoint, e.g. an organization API
+// key listing organizations, and is not an error.
+func (c *Client) CheckToken(ctx context.Context) error {
+	var err error
+	if c.OrgID != "" {
+		_, err = c.API().GetOrg(ctx, c.OrgID)
+	} else {
+		_, err = c.API().GetOrgs(ctx, &GetOrgsQuery{Limit: "1"})
+	}
+	switch {
+	case IsUnauthorized(err):
+		return fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	case IsForbidden(err):
+		return nil
+	}
+	return err
+}
+
+// APIKeyID returns the ID of the API key a token of the form
+// <apiKeyId>.<secret> belongs to.
+func APIKeyID(token string) (string, bool) {
+	id, _, ok := strings.Cut(token, ".")
+	return id, ok && id != ""
+}
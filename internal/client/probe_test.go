@@ -0,0 +1,84 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPing(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		body   string
+		want   error
+	}{
+		"integration API": {http.StatusOK, `{"success":true,"data":"Healthy"}`, nil},
+		"dashboard":       {http.StatusOK, `<!DOCTYPE html><html></html>`, ErrNotIntegrationAPI},
+		"wrong path":      {http.StatusNotFound, `Cannot GET /`, ErrNotIntegrationAPI},
+		"bad token":       {http.StatusUnauthorized, `{"success":false,"message":"Key is not valid"}`, ErrInvalidToken},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			err := NewClient(srv.URL, "token").Ping(t.Context())
+			if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("Ping() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckToken(t *testing.T) {
+	tests := map[string]struct {
+		orgID    string
+		status   int
+		wantPath string
+		want     error
+	}{
+		"valid, default org":   {"acme", http.StatusOK, "/org/acme", nil},
+		"valid, no org":        {"", http.StatusOK, "/orgs", nil},
+		"valid, not permitted": {"", http.StatusForbidden, "/orgs", nil},
+		"bad token":            {"acme", http.StatusUnauthorized, "/org/acme", ErrInvalidToken},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("requested %s, want %s", r.URL.Path, tt.wantPath)
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"success":true,"data":{}}`)
+			}))
+			defer srv.Close()
+
+			c := NewClient(srv.URL, "token")
+			c.OrgID = tt.orgID
+			err := c.CheckToken(t.Context())
+			if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("CheckToken() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIKeyID(t *testing.T) {
+	tests := map[string]string{
+		"key1.secret":  "key1",
+		"key1.sec.ret": "key1",
+		"opaque":       "",
+		".secret":      "",
+		"":             "",
+	}
+	for token, want := range tests {
+		if got, ok := APIKeyID(token); ok != (want != "") || ok && got != want {
+			t.Errorf("APIKeyID(%q) = %q, %t, want %q", token, got, ok, want)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RecorderMode selects whether a Recorder captures or serves traffic.
+type RecorderMode int
+
+const (
+	// ModeRecord forwards requests to the API and captures every exchange.
+	ModeRecord RecorderMode = iota
+	// ModeReplay answers requests from a cassette without network access.
+	ModeReplay
+)
+
+// redacted replaces secrets in recorded exchanges.
+const redacted = "[REDACTED]"
+
+// Cassette is a recorded sequence of API exchanges.
+type Cassette struct {
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+// Interaction is one recorded request and the response it received.
+type Interaction struct {
+	Request  RecordedRequest  `yaml:"request"`
+	Response RecordedResponse `yaml:"response"`
+}
+
+// RecordedRequest identifies a request. URL holds the path and query only,
+// so a cassette replays against any host.
+type RecordedRequest struct {
+	Method        string `yaml:"method"`
+	URL           string `yaml:"url"`
+	Authorization string `yaml:"authorization,omitempty"`
+	Body          string `yaml:"body,omitempty"`
+}
+
+// RecordedResponse is the status and body the API answered with.
+type RecordedResponse struct {
+	Status int    `yaml:"status"`
+	Body   string `yaml:"body"`
+}
+
+// Recorder is an http.RoundTripper that records API traffic to a cassette
+// file or replays it from one. The bearer token, any value passed to Redact,
+// and the values of the secret properties logging masks, such as API key and
+// newt secrets, are scrubbed before an exchange is stored.
+//
+// Replay answers each request with the first unused interaction of the same
+// method, URL and body, so identical requests get their responses in recorded
+// order. A request without a match fails with an error naming the request and
+// the interaction the cassette expected next.
+type Recorder struct {
+	mode RecorderMode
+	path string
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+	secrets  []string
+	count    int
+}
+
+// NewRecorder returns a recorder for the cassette at path. In ModeRecord
+// requests are sent through next, or http.DefaultTransport when nil; in
+// ModeReplay the cassette is loaded and must exist.
+func NewRecorder(path string, mode RecorderMode, next http.RoundTripper) (*Recorder, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	r := &Recorder{mode: mode, path: path, next: next}
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("loading cassette: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("parsing cassette %s: %w", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Redact adds values to scrub from recorded requests and responses.
+func (r *Recorder) Redact(secrets ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range secrets {
+		if s != "" && !slices.Contains(r.secrets, s) {
+			r.secrets = append(r.secrets, s)
+		}
+	}
+}
+
+// RoundTrip records or replays a single exchange.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	token, _ := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	r.Redact(token)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, body)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    r.scrub(req.URL.RequestURI()),
+			Body:   r.scrubBody(body),
+		},
+		Response: RecordedResponse{
+			Status: resp.StatusCode,
+			Body:   r.scrubBody(respBody),
+		},
+	}
+	if token != "" {
+		interaction.Request.Authorization = "Bearer " + redacted
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.count++
+
+	got := RecordedRequest{Method: req.Method, URL: r.scrub(req.URL.RequestURI()), Body: r.scrubBody(body)}
+	next := -1
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] {
+			continue
+		}
+		if next < 0 {
+			next = i
+		}
+		if in.Request.Method == got.Method && in.Request.URL == got.URL && sameBody(in.Request.Body, got.Body) {
+			r.used[i] = true
+			return &http.Response{
+				Status:        fmt.Sprintf("%d %s", in.Response.Status, http.StatusText(in.Response.Status)),
+				StatusCode:    in.Response.Status,
+				Proto:         "HTTP/1.1",
+				ProtoMajor:    1,
+				ProtoMinor:    1,
+				Header:        http.Header{"Content-Type": []string{"application/json"}},
+				Body:          io.NopCloser(strings.NewReader(in.Response.Body)),
+				ContentLength: int64(len(in.Response.Body)),
+				Request:       req,
+			}, nil
+		}
+	}
+
+	msg := fmt.Sprintf("cassette %s: request %d diverged from the recording\n  got:  %s", r.path, r.count, describeRequest(got))
+	if next < 0 {
+		msg += "\n  want: no further requests"
+	} else {
+		msg += fmt.Sprintf("\n  want: %s (interaction %d)", describeRequest(r.cassette.Interactions[next].Request), next+1)
+	}
+	return nil, fmt.Errorf("%s", msg)
+}
+
+// Save writes the recorded cassette to its path, creating the directory. It
+// does nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := yaml.Marshal(&r.cassette)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, data, 0o644)
+}
+
+func (r *Recorder) scrub(s string) string {
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+	return s
+}
+
+// scrubBody scrubs a request or response body, replacing the values of
+// sensitiveKeys in JSON bodies. Bodies without such values keep their
+// formatting.
+func (r *Recorder) scrubBody(body []byte) string {
+	if out, replaced := replaceSensitive(body, redacted); replaced {
+		return r.scrub(out)
+	}
+	return r.scrub(string(body))
+}
+
+// sameBody compares request bodies, as JSON when both parse.
+func sameBody(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	ca, _ := json.Marshal(va)
+	cb, _ := json.Marshal(vb)
+	return bytes.Equal(ca, cb)
+}
+
+func describeRequest(req RecordedRequest) string {
+	s := req.Method + " " + req.URL
+	if req.Body != "" {
+		s += " " + req.Body
+	}
+	return s
+}
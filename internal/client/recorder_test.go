@@ -0,0 +1,182 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	const token = "secret-token"
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == "PUT" {
+			// Echo the body, so the token shows up in the response too.
+			fmt.Fprintf(w, `{"success":true,"message":"ok","data":%s}`, body)
+			return
+		}
+		fmt.Fprintf(w, `{"success":true,"message":"ok","data":{"roleId":%d,"name":"Ops","description":"call %d"}}`, calls, calls)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "role.yaml")
+	rec, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(srv.URL+"/v1", token)
+	c.HTTPClient.Transport = rec
+
+	if _, err := c.CreateRole(t.Context(), "acme", &Role{Name: "Ops", Description: token}); err != nil {
+		t.Fatal(err)
+	}
+	first, err := c.GetRole(t.Context(), "acme", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := c.GetRole(t.Context(), "acme", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), token) {
+		t.Errorf("cassette contains the token:\n%s", data)
+	}
+	if !strings.Contains(string(data), "Bearer "+redacted) {
+		t.Errorf("cassette does not record the redacted authorization header:\n%s", data)
+	}
+
+	rep, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = NewClient("http://replay.invalid/v1", token)
+	c.HTTPClient.Transport = rep
+
+	if _, err := c.CreateRole(t.Context(), "acme", &Role{Name: "Ops", Description: token}); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []*Role{first, second} {
+		got, err := c.GetRole(t.Context(), "acme", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *got != *want {
+			t.Errorf("replayed %+v, want %+v", got, want)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("replay reached the server: %d calls", calls)
+	}
+}
+
+func TestRecorder_ReplayDivergence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "role.yaml")
+	cassette := `interactions:
+  - request:
+      method: GET
+      url: /v1/role/1
+    response:
+      status: 200
+      body: '{"success":true,"data":{"roleId":1}}'
+`
+	if err := os.WriteFile(path, []byte(cassette), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rep, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient("http://replay.invalid/v1", "token")
+	c.HTTPClient.Transport = rep
+
+	_, err = c.GetRole(t.Context(), "acme", 2)
+	if err == nil {
+		t.Fatal("expected a divergence error")
+	}
+	for _, want := range []string{"request 1 diverged", "got:  GET /v1/role/2", "want: GET /v1/role/1 (interaction 1)"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+
+	if _, err := c.GetRole(t.Context(), "acme", 1); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.GetRole(t.Context(), "acme", 1)
+	if err == nil || !strings.Contains(err.Error(), "want: no further requests") {
+		t.Errorf("expected an exhausted cassette error, got %v", err)
+	}
+}
+
+func TestRecorder_RedactsSecrets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/api-key") {
+			fmt.Fprint(w, `{"success":true,"message":"ok","data":{"apiKeyId":"k1","name":"ci","key":"k1.api-key-secret","lastChars":"cret"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"success":true,"message":"ok","data":{"siteId":1,"name":"Home","newtId":"n1","newtSecret":"newt-secret-value"}}`)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "secrets.yaml")
+	rec, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(srv.URL+"/v1", "token")
+	c.HTTPClient.Transport = rec
+
+	site := &PutOrgSiteBody{Name: "Home", Type: SiteTypeNewt, NewtID: ptr("n1"), Secret: ptr("request-secret")}
+	if _, err := c.CreateAPIKey(t.Context(), "acme", "ci"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.API().PutOrgSite(t.Context(), "acme", site); err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"k1.api-key-secret", "request-secret", "newt-secret-value"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+
+	rep, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = NewClient("http://replay.invalid/v1", "token")
+	c.HTTPClient.Transport = rep
+
+	key, err := c.CreateAPIKey(t.Context(), "acme", "ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.ID != "k1" || key.Key != redacted {
+		t.Errorf("replayed %+v, want the key redacted", key)
+	}
+	if _, err := c.API().PutOrgSite(t.Context(), "acme", site); err != nil {
+		t.Errorf("a request carrying a secret does not replay: %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TransportConfig configures the connection to the API. The zero value uses
+// the system roots, http.ProxyFromEnvironment and no extra headers.
+type TransportConfig struct {
+	// CACertPEM holds certificates trusted in addition to the system roots.
+	CACertPEM []byte
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+	// ClientCertPEM and ClientKeyPEM authenticate the client with mutual TLS.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// ProxyURL routes requests through a proxy instead of the environment's.
+	ProxyURL string
+	// Headers are added to every request. They never replace the headers the
+	// client sets itself, such as Authorization.
+	Headers map[string]string
+}
+
+// NewTransport builds the HTTP transport described by cfg.
+func NewTransport(cfg TransportConfig) (http.RoundTripper, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+	if len(cfg.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(cfg.CACertPEM) {
+			return nil, errors.New("the CA certificate contains no PEM encoded certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if len(cfg.ClientCertPEM) > 0 || len(cfg.ClientKeyPEM) > 0 {
+		cert, err := tls.X509KeyPair(cfg.ClientCertPEM, cfg.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("loading the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.ProxyURL != "" {
+		proxy, err := url.Parse(cfg.ProxyURL)
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", cfg.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if len(cfg.Headers) == 0 {
+		return transport, nil
+	}
+	headers := make(http.Header, len(cfg.Headers))
+	for k, v := range cfg.Headers {
+		headers.Set(k, v)
+	}
+	return &headerTransport{headers: headers, next: transport}, nil
+}
+
+// headerTransport adds headers to requests that do not set them already.
+type headerTransport struct {
+	headers http.Header
+	next    http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range t.headers {
+		if _, ok := req.Header[k]; !ok {
+			req.Header[k] = v
+		}
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func okHandler(w http.ResponseWriter, _ *http.Request) {
+	fmt.Fprint(w, `{"success":true,"data":{"roles":[]}}`)
+}
+
+func clientWith(t *testing.T, url string, cfg TransportConfig) *Client {
+	t.Helper()
+	transport, err := NewTransport(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(url, "token")
+	c.HTTPClient.Transport = transport
+	return c
+}
+
+func TestTransport_ServerCertificate(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(okHandler))
+	defer srv.Close()
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	if _, err := clientWith(t, srv.URL, TransportConfig{}).ListRoles(t.Context(), "acme"); err == nil {
+		t.Error("expected the self-signed certificate to be rejected")
+	}
+	if _, err := clientWith(t, srv.URL, TransportConfig{CACertPEM: caPEM}).ListRoles(t.Context(), "acme"); err != nil {
+		t.Errorf("trusted CA: %v", err)
+	}
+	if _, err := clientWith(t, srv.URL, TransportConfig{InsecureSkipVerify: true}).ListRoles(t.Context(), "acme"); err != nil {
+		t.Errorf("insecure_skip_verify: %v", err)
+	}
+	if _, err := NewTransport(TransportConfig{CACertPEM: []byte("not a certificate")}); err == nil {
+		t.Error("expected an error for a CA without certificates")
+	}
+}
+
+func TestTransport_ClientCertificate(t *testing.T) {
+	certPEM, keyPEM, cert := newClientCertificate(t)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(okHandler))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: pool}
+	srv.StartTLS()
+	defer srv.Close()
+
+	if _, err := clientWith(t, srv.URL, TransportConfig{InsecureSkipVerify: true}).ListRoles(t.Context(), "acme"); err == nil {
+		t.Error("expected the server to require a client certificate")
+	}
+	cfg := TransportConfig{InsecureSkipVerify: true, ClientCertPEM: certPEM, ClientKeyPEM: keyPEM}
+	if _, err := clientWith(t, srv.URL, cfg).ListRoles(t.Context(), "acme"); err != nil {
+		t.Errorf("mutual TLS: %v", err)
+	}
+	if _, err := NewTransport(TransportConfig{ClientCertPEM: certPEM}); err == nil {
+		t.Error("expected an error for a client certificate without a key")
+	}
+}
+
+func TestTransport_ProxyAndHeaders(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+		if got := r.Header.Get("X-Tenant"); got != "blue" {
+			t.Errorf("X-Tenant = %q", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("extra headers replaced Authorization: %q", got)
+		}
+		okHandler(w, r)
+	}))
+	defer proxy.Close()
+
+	c := clientWith(t, "http://pangolin.invalid/v1", TransportConfig{
+		ProxyURL: proxy.URL,
+		Headers:  map[string]string{"X-Tenant": "blue", "Authorization": "Basic nope"},
+	})
+	if _, err := c.ListRoles(t.Context(), "acme"); err != nil {
+		t.Fatal(err)
+	}
+	if len(proxied) != 1 || !strings.HasPrefix(proxied[0], "http://pangolin.invalid/v1/org/acme/roles") {
+		t.Errorf("proxy saw %v", proxied)
+	}
+
+	if _, err := NewTransport(TransportConfig{ProxyURL: "proxy:3128"}); err == nil {
+		t.Error("expected an error for a proxy URL without a scheme")
+	}
+}
+
+// newClientCertificate returns a self-signed client certificate and its key.
+func newClientCertificate(t *testing.T) (certPEM, keyPEM []byte, cert *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, cert
+}
@@ -0,0 +1,98 @@
+// Package credentials reads the Pangolin credentials file, which holds named
+// profiles of connection settings in an INI-style format:
+//
+//	[default]
+//	base_url = https://pangolin.example.com/v1
+//	token    = <api key>
+//	org_id   = acme
+//
+//	[staging]
+//	base_url = https://pangolin.staging.example.com/v1
+//	token    = <api key>
+//
+// Blank lines and lines starting with # or ; are ignored.
+package credentials
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is the profile used when none is selected.
+const DefaultProfile = "default"
+
+// Profile is one named section of the credentials file.
+type Profile struct {
+	BaseURL string
+	Token   string
+	OrgID   string
+}
+
+// DefaultPath returns the location of the credentials file:
+// $XDG_CONFIG_HOME/pangolin/credentials, or ~/.config/pangolin/credentials.
+func DefaultPath() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "pangolin", "credentials"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "pangolin", "credentials"), nil
+}
+
+// Load parses the credentials file at path into profiles by name.
+func Load(path string) (map[string]Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles := map[string]Profile{}
+	name := ""
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";"):
+			continue
+		case strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]"):
+			name = strings.TrimSpace(text[1 : len(text)-1])
+			if name == "" {
+				return nil, fmt.Errorf("%s:%d: empty profile name", path, line)
+			}
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = Profile{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value", path, line)
+		}
+		if name == "" {
+			return nil, fmt.Errorf("%s:%d: %s is outside of a [profile] section", path, line, strings.TrimSpace(key))
+		}
+		p := profiles[name]
+		switch key, value = strings.TrimSpace(key), strings.TrimSpace(value); key {
+		case "base_url":
+			p.BaseURL = value
+		case "token":
+			p.Token = value
+		case "org_id":
+			p.OrgID = value
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown key %s", path, line, key)
+		}
+		profiles[name] = p
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
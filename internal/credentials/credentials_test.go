@@ -0,0 +1,75 @@
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeFile(t, `
+# Production
+[default]
+base_url = https://pangolin.example.com/v1
+token    = abc.def=
+org_id   = acme
+
+; Staging has no default organization
+[ staging ]
+token = xyz
+`)
+	profiles, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]Profile{
+		"default": {BaseURL: "https://pangolin.example.com/v1", Token: "abc.def=", OrgID: "acme"},
+		"staging": {Token: "xyz"},
+	}
+	if len(profiles) != len(want) {
+		t.Fatalf("got %d profiles, want %d: %+v", len(profiles), len(want), profiles)
+	}
+	for name, p := range want {
+		if profiles[name] != p {
+			t.Errorf("profile %s = %+v, want %+v", name, profiles[name], p)
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := map[string]string{
+		"token = abc\n":              "outside of a [profile] section",
+		"[default]\ncolour = red\n":  "unknown key colour",
+		"[default]\njust text\n":     "expected key = value",
+		"[]\n":                       "empty profile name",
+		"[default]\ntoken = a\n[\n]": "expected key = value",
+	}
+	for content, want := range tests {
+		_, err := Load(writeFile(t, content))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Load(%q) = %v, want an error containing %q", content, err, want)
+		}
+	}
+}
+
+func TestDefaultPath(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	if got, _ := DefaultPath(); got != filepath.Join("/xdg", "pangolin", "credentials") {
+		t.Errorf("with XDG_CONFIG_HOME: %s", got)
+	}
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("HOME", "/home/tf")
+	if got, _ := DefaultPath(); got != filepath.Join("/home/tf", ".config", "pangolin", "credentials") {
+		t.Errorf("without XDG_CONFIG_HOME: %s", got)
+	}
+}
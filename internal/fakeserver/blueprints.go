@@ -0,0 +1,126 @@
+package fakeserver
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// Blueprint is the record of one blueprint apply. The fake checks that the
+// targets of the blueprint's proxy resources use sites of the organization,
+// but does not create the objects the blueprint describes.
+type Blueprint struct {
+	BlueprintID int     `json:"blueprintId"`
+	OrgID       string  `json:"orgId"`
+	Name        string  `json:"name"`
+	Source      string  `json:"source"`
+	Succeeded   bool    `json:"succeeded"`
+	Contents    string  `json:"contents"`
+	Message     *string `json:"message"`
+	CreatedAt   int64   `json:"createdAt"`
+}
+
+func (s *Server) routeBlueprints(mux *http.ServeMux) {
+	s.handle(mux, "PUT /org/{orgId}/blueprint", s.applyBlueprint)
+	s.handle(mux, "GET /org/{orgId}/blueprints", func(r *http.Request) (any, error) {
+		o, err := s.org(r.PathValue("orgId"))
+		if err != nil {
+			return nil, err
+		}
+		blueprints, p, err := page(r, sortedByID(s.blueprints, func(bp *Blueprint) bool { return bp.OrgID == o.OrgID }))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"blueprints": blueprints, "pagination": p}, nil
+	})
+	s.handle(mux, "GET /org/{orgId}/blueprint/{blueprintId}", func(r *http.Request) (any, error) {
+		id, err := pathInt(r, "blueprintId")
+		if err != nil {
+			return nil, err
+		}
+		bp, ok := s.blueprints[id]
+		if !ok || bp.OrgID != r.PathValue("orgId") {
+			return nil, errorf(http.StatusNotFound, "Blueprint with ID %d not found", id)
+		}
+		return bp, nil
+	})
+}
+
+// applyBlueprint records an apply. A blueprint that is not a base64 encoded
+// JSON object is rejected; one that uses an unknown site is recorded as
+// failed, as Pangolin does.
+func (s *Server) applyBlueprint(r *http.Request) (any, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	var body struct {
+		Blueprint string `json:"blueprint"`
+	}
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	contents, err := base64.StdEncoding.DecodeString(body.Blueprint)
+	if err != nil {
+		return nil, errorf(http.StatusBadRequest, "Invalid base64 blueprint")
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(contents, &doc); err != nil {
+		return nil, errorf(http.StatusBadRequest, "Invalid blueprint JSON: %v", err)
+	}
+
+	id := s.nextID("blueprint")
+	message := "Blueprint applied successfully"
+	bp := &Blueprint{
+		BlueprintID: id,
+		OrgID:       o.OrgID,
+		Name:        fmt.Sprintf("Blueprint %d", id),
+		Source:      "API",
+		Succeeded:   true,
+		Contents:    string(contents),
+		Message:     &message,
+		CreatedAt:   time.Now().Unix(),
+	}
+	if site, ok := s.unknownBlueprintSite(o.OrgID, doc); ok {
+		message = fmt.Sprintf("Site %s not found", site)
+		bp.Succeeded = false
+	}
+	s.blueprints[id] = bp
+	return bp, nil
+}
+
+// unknownBlueprintSite returns the first site used by a target of the
+// blueprint's proxy resources that is not a site of the organization.
+func (s *Server) unknownBlueprintSite(orgID string, doc map[string]any) (string, bool) {
+	resources, _ := doc["proxy-resources"].(map[string]any)
+	keys := make([]string, 0, len(resources))
+	for key := range resources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		res, _ := resources[key].(map[string]any)
+		targets, _ := res["targets"].([]any)
+		for _, t := range targets {
+			target, _ := t.(map[string]any)
+			site, ok := target["site"].(string)
+			if ok && !s.orgHasSite(orgID, site) {
+				return site, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (s *Server) orgHasSite(orgID string, niceID string) bool {
+	for _, site := range s.sites {
+		if site.OrgID == orgID && site.NiceID == niceID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+package fakeserver
+
+import (
+	"net/http"
+	"slices"
+)
+
+// grants points at the role, user and client access lists of a resource or
+// site resource. clientIDs is nil for objects clients cannot be granted.
+type grants struct {
+	orgID     string
+	roleIDs   *[]int
+	userIDs   *[]string
+	clientIDs *[]int
+}
+
+// routeGrants registers the list, set, add and remove endpoints for the access
+// lists of the object found by lookup under prefix.
+func (s *Server) routeGrants(mux *http.ServeMux, prefix string, lookup func(r *http.Request) (grants, error)) {
+	s.handle(mux, "GET "+prefix+"/roles", func(r *http.Request) (any, error) {
+		g, err := lookup(r)
+		if err != nil {
+			return nil, err
+		}
+		roles := make([]Role, 0, len(*g.roleIDs))
+		for _, id := range *g.roleIDs {
+			roles = append(roles, *s.roles[id])
+		}
+		return map[string]any{"roles": roles}, nil
+	})
+	s.handle(mux, "POST "+prefix+"/roles", func(r *http.Request) (any, error) {
+		g, err := lookup(r)
+		if err != nil {
+			return nil, err
+		}
+		var body struct {
+			RoleIDs []int `json:"roleIds"`
+		}
+		if err := decode(r, &body); err != nil {
+			return nil, err
+		}
+		return nil, s.setRoles(g, body.RoleIDs)
+	})
+	s.handle(mux, "POST "+prefix+"/roles/add", func(r *http.Request) (any, error) {
+		g, id, err := lookupWithID[int](r, lookup, "roleId")
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.setRoles(g, append(slices.Clone(*g.roleIDs), id))
+	})
+	s.handle(mux, "POST "+prefix+"/roles/remove", func(r *http.Request) (any, error) {
+		g, id, err := lookupWithID[int](r, lookup, "roleId")
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.setRoles(g, slices.DeleteFunc(slices.Clone(*g.roleIDs), func(v int) bool { return v == id }))
+	})
+
+	s.handle(mux, "GET "+prefix+"/users", func(r *http.Request) (any, error) {
+		g, err := lookup(r)
+		if err != nil {
+			return nil, err
+		}
+		users := make([]map[string]any, 0, len(*g.userIDs))
+		for _, id := range *g.userIDs {
+			u := s.users[id]
+			users = append(users, map[string]any{"userId": u.UserID, "email": u.Email, "username": u.Username, "name": u.Name})
+		}
+		return map[string]any{"users": users}, nil
+	})
+	s.handle(mux, "POST "+prefix+"/users", func(r *http.Request) (any, error) {
+		g, err := lookup(r)
+		if err != nil {
+			return nil, err
+		}
+		var body struct {
+			UserIDs []string `json:"userIds"`
+		}
+		if err := decode(r, &body); err != nil {
+			return nil, err
+		}
+		return nil, s.setUsers(g, body.UserIDs)
+	})
+	s.handle(mux, "POST "+prefix+"/users/add", func(r *http.Request) (any, error) {
+		g, id, err := lookupWithID[string](r, lookup, "userId")
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.setUsers(g, append(slices.Clone(*g.userIDs), id))
+	})
+	s.handle(mux, "POST "+prefix+"/users/remove", func(r *http.Request) (any, error) {
+		g, id, err := lookupWithID[string](r, lookup, "userId")
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.setUsers(g, slices.DeleteFunc(slices.Clone(*g.userIDs), func(v string) bool { return v == id }))
+	})
+}
+
+// routeClientGrants registers the client access list endpoints, which only
+// site resources have. The fake keeps no clients, so any client ID is accepted.
+func (s *Server) routeClientGrants(mux *http.ServeMux, prefix string, lookup func(r *http.Request) (grants, error)) {
+	s.handle(mux, "GET "+prefix+"/clients", func(r *http.Request) (any, error) {
+		g, err := lookup(r)
+		if err != nil {
+			return nil, err
+		}
+		clients := make([]map[string]any, 0, len(*g.clientIDs))
+		for _, id := range *g.clientIDs {
+			clients = append(clients, map[string]any{"clientId": id})
+		}
+		return map[string]any{"clients": clients}, nil
+	})
+	s.handle(mux, "POST "+prefix+"/clients", func(r *http.Request) (any, error) {
+		g, err := lookup(r)
+		if err != nil {
+			return nil, err
+		}
+		var body struct {
+			ClientIDs []int `json:"clientIds"`
+		}
+		if err := decode(r, &body); err != nil {
+			return nil, err
+		}
+		*g.clientIDs = uniq(body.ClientIDs)
+		return nil, nil
+	})
+	s.handle(mux, "POST "+prefix+"/clients/add", func(r *http.Request) (any, error) {
+		g, id, err := lookupWithID[int](r, lookup, "clientId")
+		if err != nil {
+			return nil, err
+		}
+		*g.clientIDs = uniq(append(*g.clientIDs, id))
+		return nil, nil
+	})
+	s.handle(mux, "POST "+prefix+"/clients/remove", func(r *http.Request) (any, error) {
+		g, id, err := lookupWithID[int](r, lookup, "clientId")
+		if err != nil {
+			return nil, err
+		}
+		*g.clientIDs = slices.DeleteFunc(*g.clientIDs, func(v int) bool { return v == id })
+		return nil, nil
+	})
+}
+
+// lookupWithID resolves the object and decodes the single ID in the body of
+// an add or remove request.
+func lookupWithID[T int | string](r *http.Request, lookup func(r *http.Request) (grants, error), key string) (grants, T, error) {
+	var id T
+	g, err := lookup(r)
+	if err != nil {
+		return g, id, err
+	}
+	var body map[string]*T
+	if err := decode(r, &body); err != nil {
+		return g, id, err
+	}
+	if body[key] == nil {
+		return g, id, errorf(http.StatusBadRequest, "%s is required", key)
+	}
+	return g, *body[key], nil
+}
+
+// setRoles replaces the roles granted access. Like Pangolin, the admin role
+// always keeps access.
+func (s *Server) setRoles(g grants, roleIDs []int) error {
+	if err := s.orgRoles(g.orgID, roleIDs); err != nil {
+		return err
+	}
+	ids := []int{}
+	if admin, ok := s.adminRole(g.orgID); ok {
+		ids = append(ids, admin.RoleID)
+	}
+	*g.roleIDs = uniq(append(ids, roleIDs...))
+	return nil
+}
+
+// setUsers replaces the users granted access, which must be members of the
+// organization.
+func (s *Server) setUsers(g grants, userIDs []string) error {
+	for _, id := range userIDs {
+		if _, _, err := s.orgUser(g.orgID, id); err != nil {
+			return err
+		}
+	}
+	*g.userIDs = uniq(userIDs)
+	return nil
+}
+
+func (s *Server) adminRole(orgID string) (*Role, bool) {
+	for _, role := range s.roles {
+		if role.OrgID == orgID && role.IsAdmin {
+			return role, true
+		}
+	}
+	return nil, false
+}
+
+// uniq drops repeated values, keeping the first occurrence.
+func uniq[T comparable](values []T) []T {
+	seen := make(map[T]bool, len(values))
+	out := make([]T, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,145 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Idp is an OpenID Connect identity provider.
+type Idp struct {
+	IdpID          int    `json:"idpId"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	AutoProvision  bool   `json:"autoProvision"`
+	ClientID       string `json:"-"`
+	ClientSecret   string `json:"-"`
+	AuthURL        string `json:"-"`
+	TokenURL       string `json:"-"`
+	IdentifierPath string `json:"-"`
+	EmailPath      string `json:"-"`
+	NamePath       string `json:"-"`
+	Scopes         string `json:"-"`
+}
+
+// IdpClientSecret returns the client secret last sent for an IdP, which the
+// API never returns.
+func (s *Server) IdpClientSecret(idpID int) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idp, ok := s.idps[idpID]
+	if !ok {
+		return "", false
+	}
+	return idp.ClientSecret, true
+}
+
+func (s *Server) routeIdps(mux *http.ServeMux) {
+	s.handle(mux, "PUT /idp/oidc", s.createIdp)
+	s.handle(mux, "GET /idp/{idpId}", func(r *http.Request) (any, error) {
+		idp, err := s.idpFromPath(r)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{
+			"idp": idp,
+			"idpOidcConfig": map[string]any{
+				"clientId":       idp.ClientID,
+				"authUrl":        idp.AuthURL,
+				"tokenUrl":       idp.TokenURL,
+				"identifierPath": idp.IdentifierPath,
+				"emailPath":      idp.EmailPath,
+				"namePath":       idp.NamePath,
+				"scopes":         idp.Scopes,
+			},
+			"redirectUrl": redirectURL(idp.IdpID),
+		}, nil
+	})
+	s.handle(mux, "POST /idp/{idpId}/oidc", s.updateIdp)
+	s.handle(mux, "DELETE /idp/{idpId}", func(r *http.Request) (any, error) {
+		idp, err := s.idpFromPath(r)
+		if err != nil {
+			return nil, err
+		}
+		delete(s.idps, idp.IdpID)
+		return nil, nil
+	})
+}
+
+func redirectURL(idpID int) string {
+	return fmt.Sprintf("https://pangolin.example.com/auth/idp/%d/oidc/callback", idpID)
+}
+
+func (s *Server) idpFromPath(r *http.Request) (*Idp, error) {
+	id, err := pathInt(r, "idpId")
+	if err != nil {
+		return nil, err
+	}
+	idp, ok := s.idps[id]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "IdP with ID %d not found", id)
+	}
+	return idp, nil
+}
+
+type idpBody struct {
+	Name           *string `json:"name"`
+	ClientID       *string `json:"clientId"`
+	ClientSecret   *string `json:"clientSecret"`
+	AuthURL        *string `json:"authUrl"`
+	TokenURL       *string `json:"tokenUrl"`
+	IdentifierPath *string `json:"identifierPath"`
+	EmailPath      *string `json:"emailPath"`
+	NamePath       *string `json:"namePath"`
+	Scopes         *string `json:"scopes"`
+	AutoProvision  *bool   `json:"autoProvision"`
+}
+
+func (s *Server) createIdp(r *http.Request) (any, error) {
+	var body idpBody
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	for name, v := range map[string]*string{
+		"name": body.Name, "clientId": body.ClientID, "clientSecret": body.ClientSecret,
+		"authUrl": body.AuthURL, "tokenUrl": body.TokenURL, "identifierPath": body.IdentifierPath, "scopes": body.Scopes,
+	} {
+		if v == nil || *v == "" {
+			return nil, errorf(http.StatusBadRequest, "%s is required", name)
+		}
+	}
+
+	idp := &Idp{IdpID: s.nextID("idp"), Type: "oidc"}
+	applyIdp(idp, body)
+	s.idps[idp.IdpID] = idp
+	return map[string]any{"idpId": idp.IdpID, "redirectUrl": redirectURL(idp.IdpID)}, nil
+}
+
+func (s *Server) updateIdp(r *http.Request) (any, error) {
+	idp, err := s.idpFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+	var body idpBody
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	applyIdp(idp, body)
+	return map[string]any{"idpId": idp.IdpID}, nil
+}
+
+// applyIdp sets the fields present in body, like Pangolin's partial updates.
+func applyIdp(idp *Idp, body idpBody) {
+	for dst, v := range map[*string]*string{
+		&idp.Name: body.Name, &idp.ClientID: body.ClientID, &idp.ClientSecret: body.ClientSecret,
+		&idp.AuthURL: body.AuthURL, &idp.TokenURL: body.TokenURL, &idp.IdentifierPath: body.IdentifierPath,
+		&idp.EmailPath: body.EmailPath, &idp.NamePath: body.NamePath, &idp.Scopes: body.Scopes,
+	} {
+		if v != nil {
+			*dst = *v
+		}
+	}
+	if body.AutoProvision != nil {
+		idp.AutoProvision = *body.AutoProvision
+	}
+}
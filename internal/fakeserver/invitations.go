@@ -0,0 +1,103 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Invitation is an open invitation of an email address to an organization.
+type Invitation struct {
+	InviteID  string `json:"inviteId"`
+	OrgID     string `json:"-"`
+	Email     string `json:"email"`
+	RoleID    int    `json:"roleId"`
+	RoleName  string `json:"roleName"`
+	ExpiresAt int64  `json:"expiresAt"`
+
+	seq int
+}
+
+func (s *Server) routeInvitations(mux *http.ServeMux) {
+	s.handle(mux, "POST /org/{orgId}/create-invite", s.createInvitation)
+	s.handle(mux, "GET /org/{orgId}/invitations", func(r *http.Request) (any, error) {
+		o, err := s.org(r.PathValue("orgId"))
+		if err != nil {
+			return nil, err
+		}
+		var invitations []Invitation
+		for _, inv := range s.invitations {
+			if inv.OrgID == o.OrgID {
+				invitations = append(invitations, *inv)
+			}
+		}
+		sort.Slice(invitations, func(i, j int) bool { return invitations[i].seq < invitations[j].seq })
+		invitations, p, err := page(r, invitations)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"invitations": invitations, "pagination": p}, nil
+	})
+	s.handle(mux, "DELETE /org/{orgId}/invitations/{inviteId}", func(r *http.Request) (any, error) {
+		inv, ok := s.invitations[r.PathValue("inviteId")]
+		if !ok || inv.OrgID != r.PathValue("orgId") {
+			return nil, errorf(http.StatusNotFound, "Invitation with ID %s not found", r.PathValue("inviteId"))
+		}
+		delete(s.invitations, inv.InviteID)
+		return nil, nil
+	})
+}
+
+// createInvitation invites an email address, or regenerates its open
+// invitation when asked to. Like Pangolin, it answers with the link and the
+// expiry only.
+func (s *Server) createInvitation(r *http.Request) (any, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	var body struct {
+		Email      string  `json:"email"`
+		RoleID     int     `json:"roleId"`
+		ValidHours float64 `json:"validHours"`
+		SendEmail  bool    `json:"sendEmail"`
+		Regenerate bool    `json:"regenerate"`
+	}
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	if body.Email == "" {
+		return nil, errorf(http.StatusBadRequest, "email is required")
+	}
+	if body.ValidHours <= 0 || body.ValidHours > 168 {
+		return nil, errorf(http.StatusBadRequest, "Invalid validHours %v", body.ValidHours)
+	}
+	if err := s.orgRoles(o.OrgID, []int{body.RoleID}); err != nil {
+		return nil, err
+	}
+
+	var inv *Invitation
+	for _, existing := range s.invitations {
+		if existing.OrgID == o.OrgID && strings.EqualFold(existing.Email, body.Email) {
+			inv = existing
+		}
+	}
+	switch {
+	case inv == nil:
+		seq := s.nextID("invitation")
+		inv = &Invitation{InviteID: fmt.Sprintf("inv-%d", seq), OrgID: o.OrgID, Email: body.Email, seq: seq}
+		s.invitations[inv.InviteID] = inv
+	case !body.Regenerate:
+		return nil, errorf(http.StatusConflict, "User has already been invited")
+	}
+	inv.RoleID = body.RoleID
+	inv.RoleName = s.roles[body.RoleID].Name
+	inv.ExpiresAt = time.Now().Add(time.Duration(body.ValidHours * float64(time.Hour))).UnixMilli()
+
+	return map[string]any{
+		"inviteLink": fmt.Sprintf("https://pangolin.example.com/invite?token=%s-%d", inv.InviteID, s.nextID("inviteToken")),
+		"expiresAt":  inv.ExpiresAt,
+	}, nil
+}
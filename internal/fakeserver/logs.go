@@ -0,0 +1,202 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// requestLogPageSize is the number of entries the request log endpoint
+// returns at most. The endpoint has no offset, so clients page by moving
+// timeEnd back, as with Pangolin.
+const requestLogPageSize = 1000
+
+// RequestLog is an entry of the request audit log. Timestamp is in Unix
+// seconds and Action is true for allowed requests.
+type RequestLog struct {
+	ID         int     `json:"id"`
+	OrgID      string  `json:"-"`
+	Timestamp  int64   `json:"timestamp"`
+	Action     bool    `json:"action"`
+	Reason     int     `json:"reason"`
+	ActorType  *string `json:"actorType"`
+	Actor      *string `json:"actor"`
+	ResourceID *int    `json:"resourceId"`
+	IP         *string `json:"ip"`
+	Location   *string `json:"location"`
+	Method     *string `json:"method"`
+	Host       *string `json:"host"`
+	Path       *string `json:"path"`
+}
+
+// AddRequestLog stores a request log entry in an existing organization and
+// returns its ID. The fake has no proxy, so tests seed the log directly.
+func (s *Server) AddRequestLog(orgID string, entry RequestLog) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.orgs[orgID]; !ok {
+		panic(fmt.Sprintf("fakeserver: organization %q does not exist", orgID))
+	}
+	entry.ID = s.nextID("requestLog")
+	entry.OrgID = orgID
+	s.requestLogs[entry.ID] = &entry
+	return entry.ID
+}
+
+func (s *Server) routeLogs(mux *http.ServeMux) {
+	s.handle(mux, "GET /org/{orgId}/logs/request", func(r *http.Request) (any, error) {
+		entries, err := s.queryRequestLogs(r, true)
+		if err != nil {
+			return nil, err
+		}
+		sort.SliceStable(entries, func(i, j int) bool {
+			if entries[i].Timestamp != entries[j].Timestamp {
+				return entries[i].Timestamp > entries[j].Timestamp
+			}
+			return entries[i].ID > entries[j].ID
+		})
+		p := pagination{Total: len(entries), Limit: requestLogPageSize}
+		if len(entries) > requestLogPageSize {
+			entries = entries[:requestLogPageSize]
+		}
+		return map[string]any{"log": entries, "pagination": p}, nil
+	})
+	s.handle(mux, "GET /org/{orgId}/logs/analytics", func(r *http.Request) (any, error) {
+		entries, err := s.queryRequestLogs(r, false)
+		if err != nil {
+			return nil, err
+		}
+		return requestAnalytics(entries), nil
+	})
+}
+
+// queryRequestLogs returns the entries of the organization in the time window
+// of the request, which defaults to the last 7 days and includes both ends.
+// The remaining filters only apply to the request log endpoint.
+func (s *Server) queryRequestLogs(r *http.Request, filter bool) ([]RequestLog, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	q := r.URL.Query()
+
+	end := time.Now()
+	start := end.Add(-7 * 24 * time.Hour)
+	for name, dst := range map[string]*time.Time{"timeStart": &start, "timeEnd": &end} {
+		if v := q.Get(name); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, errorf(http.StatusBadRequest, "Invalid %s: %q", name, v)
+			}
+			*dst = t
+		}
+	}
+	resourceID := 0
+	if v := q.Get("resourceId"); v != "" {
+		if resourceID, err = strconv.Atoi(v); err != nil {
+			return nil, errorf(http.StatusBadRequest, "Invalid resourceId: %q", v)
+		}
+	}
+
+	matches := func(entry *RequestLog) bool {
+		if entry.OrgID != o.OrgID || entry.Timestamp < start.Unix() || entry.Timestamp > end.Unix() {
+			return false
+		}
+		if resourceID != 0 && (entry.ResourceID == nil || *entry.ResourceID != resourceID) {
+			return false
+		}
+		if !filter {
+			return true
+		}
+		if v := q.Get("action"); v != "" && v != strconv.FormatBool(entry.Action) {
+			return false
+		}
+		if v := q.Get("reason"); v != "" && v != strconv.Itoa(entry.Reason) {
+			return false
+		}
+		for name, field := range map[string]*string{
+			"method":   entry.Method,
+			"actor":    entry.Actor,
+			"location": entry.Location,
+			"host":     entry.Host,
+			"path":     entry.Path,
+		} {
+			if v := q.Get(name); v != "" && (field == nil || *field != v) {
+				return false
+			}
+		}
+		return true
+	}
+	return sortedByID(s.requestLogs, matches), nil
+}
+
+type countryRequestCount struct {
+	Code  string `json:"code"`
+	Count int    `json:"count"`
+}
+
+type dailyRequestCount struct {
+	Day          string `json:"day"`
+	AllowedCount int    `json:"allowedCount"`
+	BlockedCount int    `json:"blockedCount"`
+	TotalCount   int    `json:"totalCount"`
+}
+
+// requestAnalytics summarizes entries by country, most requests first, and by
+// UTC day, oldest first.
+func requestAnalytics(entries []RequestLog) map[string]any {
+	blocked := 0
+	countries := map[string]*countryRequestCount{}
+	days := map[string]*dailyRequestCount{}
+	for _, entry := range entries {
+		if entry.Location != nil {
+			c, ok := countries[*entry.Location]
+			if !ok {
+				c = &countryRequestCount{Code: *entry.Location}
+				countries[c.Code] = c
+			}
+			c.Count++
+		}
+
+		day := time.Unix(entry.Timestamp, 0).UTC().Format(time.DateOnly)
+		d, ok := days[day]
+		if !ok {
+			d = &dailyRequestCount{Day: day}
+			days[day] = d
+		}
+		d.TotalCount++
+		if entry.Action {
+			d.AllowedCount++
+		} else {
+			d.BlockedCount++
+			blocked++
+		}
+	}
+
+	perCountry := make([]*countryRequestCount, 0, len(countries))
+	for _, c := range countries {
+		perCountry = append(perCountry, c)
+	}
+	sort.Slice(perCountry, func(i, j int) bool {
+		if perCountry[i].Count != perCountry[j].Count {
+			return perCountry[i].Count > perCountry[j].Count
+		}
+		return perCountry[i].Code < perCountry[j].Code
+	})
+
+	perDay := make([]*dailyRequestCount, 0, len(days))
+	for _, d := range days {
+		perDay = append(perDay, d)
+	}
+	sort.Slice(perDay, func(i, j int) bool { return perDay[i].Day < perDay[j].Day })
+
+	return map[string]any{
+		"totalRequests":      len(entries),
+		"totalBlocked":       blocked,
+		"requestsPerCountry": perCountry,
+		"requestsPerDay":     perDay,
+	}
+}
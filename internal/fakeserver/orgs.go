@@ -0,0 +1,475 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// Org is an organization.
+type Org struct {
+	OrgID string `json:"orgId"`
+	Name  string `json:"name"`
+}
+
+// Role is an organization role.
+type Role struct {
+	RoleID      int    `json:"roleId"`
+	OrgID       string `json:"orgId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsAdmin     bool   `json:"isAdmin"`
+}
+
+// User is an account, which may belong to several organizations.
+type User struct {
+	UserID                  string `json:"userId"`
+	Email                   string `json:"email"`
+	Username                string `json:"username"`
+	Name                    string `json:"name"`
+	Type                    string `json:"type"`
+	IdpID                   *int   `json:"idpId"`
+	TwoFactorEnabled        bool   `json:"twoFactorEnabled"`
+	TwoFactorSetupRequested bool   `json:"twoFactorSetupRequested"`
+}
+
+// member is a user's membership of an organization.
+type member struct {
+	roleID  int
+	isOwner bool
+}
+
+// AddOrg creates an organization with the built-in Admin and Member roles,
+// like Pangolin does on organization creation.
+func (s *Server) AddOrg(orgID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.orgs[orgID] = &Org{OrgID: orgID, Name: orgID}
+	s.members[orgID] = map[string]*member{}
+	for _, r := range []Role{
+		{Name: "Admin", Description: "Admin role with the most permissions", IsAdmin: true},
+		{Name: "Member", Description: "Members can only view resources"},
+	} {
+		r.RoleID = s.nextID("role")
+		r.OrgID = orgID
+		s.roles[r.RoleID] = &r
+	}
+}
+
+// AddUser creates an internal user holding roleID in the organization and
+// returns its ID. It panics if the organization does not exist.
+func (s *Server) AddUser(orgID string, username string, roleID int) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members, ok := s.members[orgID]
+	if !ok {
+		panic(fmt.Sprintf("fakeserver: organization %q does not exist", orgID))
+	}
+	u := &User{
+		UserID:   fmt.Sprintf("user%011d", s.nextID("user")),
+		Email:    username + "@example.com",
+		Username: username,
+		Type:     "internal",
+	}
+	s.users[u.UserID] = u
+	members[u.UserID] = &member{roleID: roleID}
+	return u.UserID
+}
+
+// EnrollTwoFactor completes a user's two-factor enrollment, as the user does
+// by signing in. It panics if the user does not exist.
+func (s *Server) EnrollTwoFactor(userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[userID]
+	if !ok {
+		panic(fmt.Sprintf("fakeserver: user %q does not exist", userID))
+	}
+	u.TwoFactorEnabled = true
+	u.TwoFactorSetupRequested = false
+}
+
+// RoleByName returns the role with the given name in an organization.
+func (s *Server) RoleByName(orgID string, name string) (Role, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range s.roles {
+		if r.OrgID == orgID && r.Name == name {
+			return *r, true
+		}
+	}
+	return Role{}, false
+}
+
+func (s *Server) routeOrgs(mux *http.ServeMux) {
+	s.handle(mux, "GET /orgs", func(r *http.Request) (any, error) {
+		orgs := make([]Org, 0, len(s.orgs))
+		for _, o := range s.orgs {
+			orgs = append(orgs, *o)
+		}
+		sort.Slice(orgs, func(i, j int) bool { return orgs[i].OrgID < orgs[j].OrgID })
+		orgs, p, err := page(r, orgs)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"orgs": orgs, "pagination": p}, nil
+	})
+	s.handle(mux, "GET /org/{orgId}", func(r *http.Request) (any, error) {
+		return s.org(r.PathValue("orgId"))
+	})
+
+	s.handle(mux, "PUT /org/{orgId}/role", s.createRole)
+	s.handle(mux, "GET /org/{orgId}/roles", s.listRoles)
+	s.handle(mux, "GET /role/{roleId}", func(r *http.Request) (any, error) {
+		return s.roleFromPath(r)
+	})
+	s.handle(mux, "POST /role/{roleId}", s.updateRole)
+	s.handle(mux, "DELETE /role/{roleId}", s.deleteRole)
+	s.handle(mux, "POST /role/{roleId}/add/{userId}", s.addRoleToUser)
+
+	s.handle(mux, "PUT /org/{orgId}/user", s.createOrgUser)
+	s.handle(mux, "GET /org/{orgId}/users", s.listOrgUsers)
+	s.handle(mux, "GET /org/{orgId}/user/{userId}", func(r *http.Request) (any, error) {
+		u, m, err := s.orgUser(r.PathValue("orgId"), r.PathValue("userId"))
+		if err != nil {
+			return nil, err
+		}
+		return s.orgUserJSON(r.PathValue("orgId"), u, m, "userId"), nil
+	})
+	s.handle(mux, "DELETE /org/{orgId}/user/{userId}", s.removeOrgUser)
+	s.handle(mux, "GET /org/{orgId}/user/{userId}/check", func(r *http.Request) (any, error) {
+		if _, _, err := s.orgUser(r.PathValue("orgId"), r.PathValue("userId")); err != nil {
+			return nil, err
+		}
+		return map[string]bool{"allowed": true}, nil
+	})
+
+	s.handle(mux, "GET /user/{userId}", func(r *http.Request) (any, error) {
+		return s.user(r.PathValue("userId"))
+	})
+	s.handle(mux, "POST /user/{userId}/2fa", func(r *http.Request) (any, error) {
+		u, err := s.user(r.PathValue("userId"))
+		if err != nil {
+			return nil, err
+		}
+		var body struct {
+			TwoFactorSetupRequested *bool `json:"twoFactorSetupRequested"`
+		}
+		if err := decode(r, &body); err != nil {
+			return nil, err
+		}
+		if body.TwoFactorSetupRequested == nil {
+			return nil, errorf(http.StatusBadRequest, "twoFactorSetupRequested is required")
+		}
+		u.TwoFactorSetupRequested = *body.TwoFactorSetupRequested
+		return u, nil
+	})
+}
+
+func (s *Server) org(orgID string) (*Org, error) {
+	o, ok := s.orgs[orgID]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Organization with ID %s not found", orgID)
+	}
+	return o, nil
+}
+
+func (s *Server) role(roleID int) (*Role, error) {
+	role, ok := s.roles[roleID]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Role with ID %d not found", roleID)
+	}
+	return role, nil
+}
+
+func (s *Server) roleFromPath(r *http.Request) (*Role, error) {
+	id, err := pathInt(r, "roleId")
+	if err != nil {
+		return nil, err
+	}
+	return s.role(id)
+}
+
+// orgRoles checks that every role belongs to the organization.
+func (s *Server) orgRoles(orgID string, roleIDs []int) error {
+	for _, id := range roleIDs {
+		role, err := s.role(id)
+		if err != nil {
+			return err
+		}
+		if role.OrgID != orgID {
+			return errorf(http.StatusBadRequest, "Role %d does not belong to organization %s", id, orgID)
+		}
+	}
+	return nil
+}
+
+func (s *Server) createRole(r *http.Request) (any, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	var body struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	if body.Name == "" {
+		return nil, errorf(http.StatusBadRequest, "name is required")
+	}
+	if _, taken := s.roleNamed(o.OrgID, body.Name); taken {
+		return nil, errorf(http.StatusBadRequest, "Role with name %s already exists", body.Name)
+	}
+
+	role := &Role{RoleID: s.nextID("role"), OrgID: o.OrgID, Name: body.Name, Description: body.Description}
+	s.roles[role.RoleID] = role
+	return role, nil
+}
+
+func (s *Server) roleNamed(orgID string, name string) (*Role, bool) {
+	for _, role := range s.roles {
+		if role.OrgID == orgID && role.Name == name {
+			return role, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) listRoles(r *http.Request) (any, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	roles, p, err := page(r, sortedByID(s.roles, func(role *Role) bool { return role.OrgID == o.OrgID }))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"roles": roles, "pagination": p}, nil
+}
+
+func (s *Server) updateRole(r *http.Request) (any, error) {
+	role, err := s.roleFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+	if role.IsAdmin {
+		return nil, errorf(http.StatusForbidden, "Cannot update a Admin role")
+	}
+	var body struct {
+		Name        *string `json:"name"`
+		Description *string `json:"description"`
+	}
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	if body.Name != nil {
+		if other, taken := s.roleNamed(role.OrgID, *body.Name); taken && other != role {
+			return nil, errorf(http.StatusBadRequest, "Role with name %s already exists", *body.Name)
+		}
+		role.Name = *body.Name
+	}
+	if body.Description != nil {
+		role.Description = *body.Description
+	}
+	return role, nil
+}
+
+func (s *Server) deleteRole(r *http.Request) (any, error) {
+	role, err := s.roleFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+	if role.IsAdmin {
+		return nil, errorf(http.StatusForbidden, "Cannot delete a Admin role")
+	}
+	// Pangolin moves the role's users to a replacement role given as a string.
+	var body struct {
+		RoleID string `json:"roleId"`
+	}
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	newID, err := strconv.Atoi(body.RoleID)
+	if err != nil {
+		return nil, errorf(http.StatusBadRequest, "Invalid roleId: %q", body.RoleID)
+	}
+	replacement, err := s.role(newID)
+	if err != nil {
+		return nil, err
+	}
+	if replacement.OrgID != role.OrgID || replacement == role {
+		return nil, errorf(http.StatusBadRequest, "Invalid replacement role %d", newID)
+	}
+
+	for _, m := range s.members[role.OrgID] {
+		if m.roleID == role.RoleID {
+			m.roleID = replacement.RoleID
+		}
+	}
+	for _, res := range s.resources {
+		res.RoleIDs = slices.DeleteFunc(res.RoleIDs, func(id int) bool { return id == role.RoleID })
+	}
+	for _, res := range s.siteResources {
+		res.RoleIDs = slices.DeleteFunc(res.RoleIDs, func(id int) bool { return id == role.RoleID })
+	}
+	delete(s.roles, role.RoleID)
+	return nil, nil
+}
+
+func (s *Server) addRoleToUser(r *http.Request) (any, error) {
+	role, err := s.roleFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+	_, m, err := s.orgUser(role.OrgID, r.PathValue("userId"))
+	if err != nil {
+		return nil, err
+	}
+	m.roleID = role.RoleID
+	return map[string]any{"userId": r.PathValue("userId"), "roleId": role.RoleID}, nil
+}
+
+func (s *Server) user(userID string) (*User, error) {
+	u, ok := s.users[userID]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "User with ID %s not found", userID)
+	}
+	return u, nil
+}
+
+func (s *Server) orgUser(orgID string, userID string) (*User, *member, error) {
+	if _, err := s.org(orgID); err != nil {
+		return nil, nil, err
+	}
+	m, ok := s.members[orgID][userID]
+	if !ok {
+		return nil, nil, errorf(http.StatusNotFound, "User with ID %s not found in organization %s", userID, orgID)
+	}
+	return s.users[userID], m, nil
+}
+
+// orgUserJSON renders a member the way the user endpoints do. The list
+// endpoint names the user ID "id" where the single user endpoint says "userId".
+func (s *Server) orgUserJSON(orgID string, u *User, m *member, idKey string) map[string]any {
+	roleName := ""
+	if role, ok := s.roles[m.roleID]; ok {
+		roleName = role.Name
+	}
+	return map[string]any{
+		idKey:      u.UserID,
+		"orgId":    orgID,
+		"email":    u.Email,
+		"username": u.Username,
+		"name":     u.Name,
+		"type":     u.Type,
+		"idpId":    u.IdpID,
+		"roleId":   m.roleID,
+		"roleName": roleName,
+		"isOwner":  m.isOwner,
+	}
+}
+
+func (s *Server) createOrgUser(r *http.Request) (any, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	var body struct {
+		Email    string `json:"email"`
+		Username string `json:"username"`
+		Name     string `json:"name"`
+		Type     string `json:"type"`
+		IdpID    *int   `json:"idpId"`
+		RoleID   int    `json:"roleId"`
+	}
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	if body.Username == "" {
+		return nil, errorf(http.StatusBadRequest, "username is required")
+	}
+	if body.Type != "oidc" || body.IdpID == nil {
+		return nil, errorf(http.StatusBadRequest, "Only oidc users with an idpId can be created")
+	}
+	if err := s.orgRoles(o.OrgID, []int{body.RoleID}); err != nil {
+		return nil, err
+	}
+
+	var u *User
+	for _, existing := range s.users {
+		if existing.Username == body.Username && existing.IdpID != nil && *existing.IdpID == *body.IdpID {
+			u = existing
+		}
+	}
+	if u == nil {
+		u = &User{
+			UserID:   fmt.Sprintf("user%011d", s.nextID("user")),
+			Email:    body.Email,
+			Username: body.Username,
+			Name:     body.Name,
+			Type:     body.Type,
+			IdpID:    body.IdpID,
+		}
+		s.users[u.UserID] = u
+	} else if _, ok := s.members[o.OrgID][u.UserID]; ok {
+		return nil, errorf(http.StatusConflict, "User already exists in this organization")
+	}
+
+	m := &member{roleID: body.RoleID}
+	s.members[o.OrgID][u.UserID] = m
+	return s.orgUserJSON(o.OrgID, u, m, "userId"), nil
+}
+
+func (s *Server) listOrgUsers(r *http.Request) (any, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(s.members[o.OrgID]))
+	for id := range s.members[o.OrgID] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	users := make([]map[string]any, len(ids))
+	for i, id := range ids {
+		users[i] = s.orgUserJSON(o.OrgID, s.users[id], s.members[o.OrgID][id], "id")
+	}
+	users, p, err := page(r, users)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"users": users, "pagination": p}, nil
+}
+
+func (s *Server) removeOrgUser(r *http.Request) (any, error) {
+	orgID, userID := r.PathValue("orgId"), r.PathValue("userId")
+	_, m, err := s.orgUser(orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if m.isOwner {
+		return nil, errorf(http.StatusBadRequest, "Cannot remove owner from org")
+	}
+
+	for _, res := range s.resources {
+		if res.OrgID == orgID {
+			res.UserIDs = slices.DeleteFunc(res.UserIDs, func(id string) bool { return id == userID })
+		}
+	}
+	for _, res := range s.siteResources {
+		if res.OrgID == orgID {
+			res.UserIDs = slices.DeleteFunc(res.UserIDs, func(id string) bool { return id == userID })
+		}
+	}
+	delete(s.members[orgID], userID)
+	return nil, nil
+}
@@ -0,0 +1,413 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Domain is a domain of an organization that HTTP resources are served under.
+// Seeded domains are verified wildcard domains from the configuration file.
+type Domain struct {
+	DomainID      string `json:"domainId"`
+	OrgID         string `json:"-"`
+	BaseDomain    string `json:"baseDomain"`
+	Type          string `json:"type"`
+	Verified      bool   `json:"verified"`
+	Failed        bool   `json:"failed"`
+	ConfigManaged bool   `json:"configManaged"`
+}
+
+// Resource is a public resource proxied by Pangolin.
+type Resource struct {
+	ResourceID int     `json:"resourceId"`
+	NiceID     string  `json:"niceId"`
+	OrgID      string  `json:"orgId"`
+	Name       string  `json:"name"`
+	Protocol   string  `json:"protocol"`
+	HTTP       bool    `json:"http"`
+	Subdomain  *string `json:"subdomain"`
+	DomainID   *string `json:"domainId"`
+	FullDomain *string `json:"fullDomain"`
+	ProxyPort  *int    `json:"proxyPort"`
+	Enabled    bool    `json:"enabled"`
+	SSL        bool    `json:"ssl"`
+	SSO        bool    `json:"sso"`
+
+	RoleIDs []int    `json:"-"`
+	UserIDs []string `json:"-"`
+}
+
+// Target is a backend a resource forwards to through a site.
+type Target struct {
+	TargetID   int     `json:"targetId"`
+	ResourceID int     `json:"resourceId"`
+	SiteID     int     `json:"siteId"`
+	IP         string  `json:"ip"`
+	Method     *string `json:"method"`
+	Port       int     `json:"port"`
+	Enabled    bool    `json:"enabled"`
+}
+
+// AddDomain makes a domain available to the HTTP resources of an
+// organization. It panics if the organization does not exist.
+func (s *Server) AddDomain(orgID string, domainID string, baseDomain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.orgs[orgID]; !ok {
+		panic(fmt.Sprintf("fakeserver: organization %q does not exist", orgID))
+	}
+	s.domains[domainID] = &Domain{DomainID: domainID, OrgID: orgID, BaseDomain: baseDomain, Type: "wildcard", Verified: true, ConfigManaged: true}
+}
+
+func (s *Server) routeDomains(mux *http.ServeMux) {
+	s.handle(mux, "GET /org/{orgId}/domains", func(r *http.Request) (any, error) {
+		o, err := s.org(r.PathValue("orgId"))
+		if err != nil {
+			return nil, err
+		}
+		domains := []Domain{}
+		for _, d := range s.domains {
+			if d.OrgID == o.OrgID {
+				domains = append(domains, *d)
+			}
+		}
+		sort.Slice(domains, func(i, j int) bool { return domains[i].DomainID < domains[j].DomainID })
+		domains, p, err := page(r, domains)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"domains": domains, "pagination": p}, nil
+	})
+	s.handle(mux, "GET /org/{orgId}/domain/{domainId}", func(r *http.Request) (any, error) {
+		return s.orgDomain(r)
+	})
+	s.handle(mux, "GET /org/{orgId}/domain/{domainId}/dns-records", func(r *http.Request) (any, error) {
+		d, err := s.orgDomain(r)
+		if err != nil {
+			return nil, err
+		}
+		return []map[string]any{{
+			"id":         1,
+			"domainId":   d.DomainID,
+			"recordType": "A",
+			"baseDomain": "*." + d.BaseDomain,
+			"value":      "127.0.0.1",
+			"verified":   d.Verified,
+		}}, nil
+	})
+}
+
+func (s *Server) orgDomain(r *http.Request) (*Domain, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	d, ok := s.domains[r.PathValue("domainId")]
+	if !ok || d.OrgID != o.OrgID {
+		return nil, errorf(http.StatusNotFound, "Domain %s not found", r.PathValue("domainId"))
+	}
+	return d, nil
+}
+
+func (s *Server) routeResources(mux *http.ServeMux) {
+	s.handle(mux, "PUT /org/{orgId}/resource", s.createResource)
+	s.handle(mux, "GET /org/{orgId}/resources", func(r *http.Request) (any, error) {
+		o, err := s.org(r.PathValue("orgId"))
+		if err != nil {
+			return nil, err
+		}
+		resources, p, err := page(r, sortedByID(s.resources, func(res *Resource) bool { return res.OrgID == o.OrgID }))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"resources": resources, "pagination": p}, nil
+	})
+	s.handle(mux, "GET /org/{orgId}/resource/{niceId}", func(r *http.Request) (any, error) {
+		for _, res := range s.resources {
+			if res.OrgID == r.PathValue("orgId") && res.NiceID == r.PathValue("niceId") {
+				return res, nil
+			}
+		}
+		return nil, errorf(http.StatusNotFound, "Resource with niceId %s not found", r.PathValue("niceId"))
+	})
+	s.handle(mux, "GET /resource/{resourceId}", func(r *http.Request) (any, error) {
+		return s.resourceFromPath(r)
+	})
+	s.handle(mux, "POST /resource/{resourceId}", s.updateResource)
+	s.handle(mux, "DELETE /resource/{resourceId}", func(r *http.Request) (any, error) {
+		res, err := s.resourceFromPath(r)
+		if err != nil {
+			return nil, err
+		}
+		for id, t := range s.targets {
+			if t.ResourceID == res.ResourceID {
+				delete(s.targets, id)
+			}
+		}
+		delete(s.resources, res.ResourceID)
+		return nil, nil
+	})
+	s.routeGrants(mux, "/resource/{resourceId}", func(r *http.Request) (grants, error) {
+		res, err := s.resourceFromPath(r)
+		if err != nil {
+			return grants{}, err
+		}
+		return grants{orgID: res.OrgID, roleIDs: &res.RoleIDs, userIDs: &res.UserIDs}, nil
+	})
+
+	s.handle(mux, "PUT /resource/{resourceId}/target", s.createTarget)
+	s.handle(mux, "GET /resource/{resourceId}/targets", func(r *http.Request) (any, error) {
+		res, err := s.resourceFromPath(r)
+		if err != nil {
+			return nil, err
+		}
+		targets, p, err := page(r, sortedByID(s.targets, func(t *Target) bool { return t.ResourceID == res.ResourceID }))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"targets": targets, "pagination": p}, nil
+	})
+	s.handle(mux, "GET /target/{targetId}", func(r *http.Request) (any, error) {
+		return s.targetFromPath(r)
+	})
+	s.handle(mux, "POST /target/{targetId}", s.updateTarget)
+	s.handle(mux, "DELETE /target/{targetId}", func(r *http.Request) (any, error) {
+		t, err := s.targetFromPath(r)
+		if err != nil {
+			return nil, err
+		}
+		delete(s.targets, t.TargetID)
+		return nil, nil
+	})
+}
+
+func (s *Server) resourceFromPath(r *http.Request) (*Resource, error) {
+	id, err := pathInt(r, "resourceId")
+	if err != nil {
+		return nil, err
+	}
+	res, ok := s.resources[id]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Resource with ID %d not found", id)
+	}
+	return res, nil
+}
+
+// resourceBody is the create and update body of resources. protocol and http
+// are only read on create.
+type resourceBody struct {
+	Name      *string `json:"name"`
+	Protocol  string  `json:"protocol"`
+	HTTP      bool    `json:"http"`
+	Subdomain *string `json:"subdomain"`
+	DomainID  *string `json:"domainId"`
+	ProxyPort *int    `json:"proxyPort"`
+	Enabled   *bool   `json:"enabled"`
+	SSL       *bool   `json:"ssl"`
+	SSO       *bool   `json:"sso"`
+}
+
+func (s *Server) createResource(r *http.Request) (any, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	var body resourceBody
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil {
+		return nil, errorf(http.StatusBadRequest, "name is required")
+	}
+	switch body.Protocol {
+	case "tcp", "udp":
+	default:
+		return nil, errorf(http.StatusBadRequest, "Invalid protocol %q", body.Protocol)
+	}
+	if body.HTTP && body.Protocol != "tcp" {
+		return nil, errorf(http.StatusBadRequest, "HTTP resources must use tcp")
+	}
+	if !body.HTTP && body.ProxyPort == nil {
+		return nil, errorf(http.StatusBadRequest, "proxyPort is required for raw resources")
+	}
+
+	res := &Resource{OrgID: o.OrgID, Protocol: body.Protocol, HTTP: body.HTTP, Enabled: true, SSL: body.HTTP, SSO: body.HTTP}
+	if err := s.applyResource(res, body); err != nil {
+		return nil, err
+	}
+	res.ResourceID = s.nextID("resource")
+	res.NiceID = niceID(res.Name, res.ResourceID)
+	if err := s.setRoles(grants{orgID: res.OrgID, roleIDs: &res.RoleIDs}, nil); err != nil {
+		return nil, err
+	}
+	s.resources[res.ResourceID] = res
+	return res, nil
+}
+
+func (s *Server) updateResource(r *http.Request) (any, error) {
+	res, err := s.resourceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+	var body resourceBody
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	updated := *res
+	if err := s.applyResource(&updated, body); err != nil {
+		return nil, err
+	}
+	*res = updated
+	return res, nil
+}
+
+// applyResource validates body and copies the fields it sets onto res,
+// recomputing the full domain of HTTP resources.
+func (s *Server) applyResource(res *Resource, body resourceBody) error {
+	if body.Name != nil {
+		if *body.Name == "" {
+			return errorf(http.StatusBadRequest, "name must not be empty")
+		}
+		res.Name = *body.Name
+	}
+	if body.Enabled != nil {
+		res.Enabled = *body.Enabled
+	}
+	if body.SSL != nil {
+		res.SSL = *body.SSL
+	}
+	if body.SSO != nil {
+		res.SSO = *body.SSO
+	}
+
+	if !res.HTTP {
+		if body.ProxyPort != nil {
+			if *body.ProxyPort < 1 || *body.ProxyPort > 65535 {
+				return errorf(http.StatusBadRequest, "Invalid proxyPort %d", *body.ProxyPort)
+			}
+			port := *body.ProxyPort
+			res.ProxyPort = &port
+		}
+		return nil
+	}
+
+	if body.Subdomain != nil {
+		sub := *body.Subdomain
+		res.Subdomain = &sub
+	}
+	if body.DomainID != nil {
+		id := *body.DomainID
+		res.DomainID = &id
+	}
+	if res.DomainID == nil || *res.DomainID == "" {
+		return errorf(http.StatusBadRequest, "domainId is required for HTTP resources")
+	}
+	domain, ok := s.domains[*res.DomainID]
+	if !ok || domain.OrgID != res.OrgID {
+		return errorf(http.StatusNotFound, "Domain with ID %s not found", *res.DomainID)
+	}
+
+	full := domain.BaseDomain
+	if res.Subdomain != nil && *res.Subdomain != "" {
+		full = *res.Subdomain + "." + full
+	}
+	for _, other := range s.resources {
+		if other.ResourceID != res.ResourceID && other.FullDomain != nil && strings.EqualFold(*other.FullDomain, full) {
+			return errorf(http.StatusConflict, "Resource with domain %s already exists", full)
+		}
+	}
+	res.FullDomain = &full
+	return nil
+}
+
+func (s *Server) targetFromPath(r *http.Request) (*Target, error) {
+	id, err := pathInt(r, "targetId")
+	if err != nil {
+		return nil, err
+	}
+	t, ok := s.targets[id]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Target with ID %d not found", id)
+	}
+	return t, nil
+}
+
+type targetBody struct {
+	SiteID  *int    `json:"siteId"`
+	IP      *string `json:"ip"`
+	Method  *string `json:"method"`
+	Port    *int    `json:"port"`
+	Enabled *bool   `json:"enabled"`
+}
+
+func (s *Server) createTarget(r *http.Request) (any, error) {
+	res, err := s.resourceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+	var body targetBody
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	if body.SiteID == nil || body.IP == nil || body.Port == nil {
+		return nil, errorf(http.StatusBadRequest, "siteId, ip and port are required")
+	}
+
+	t := &Target{ResourceID: res.ResourceID, Enabled: true}
+	if err := s.applyTarget(res.OrgID, t, body); err != nil {
+		return nil, err
+	}
+	t.TargetID = s.nextID("target")
+	s.targets[t.TargetID] = t
+	return t, nil
+}
+
+func (s *Server) updateTarget(r *http.Request) (any, error) {
+	t, err := s.targetFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+	var body targetBody
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	updated := *t
+	if err := s.applyTarget(s.resources[t.ResourceID].OrgID, &updated, body); err != nil {
+		return nil, err
+	}
+	*t = updated
+	return t, nil
+}
+
+// applyTarget validates body and copies the fields it sets onto t.
+func (s *Server) applyTarget(orgID string, t *Target, body targetBody) error {
+	if body.SiteID != nil {
+		if _, err := s.orgSite(orgID, *body.SiteID); err != nil {
+			return err
+		}
+		t.SiteID = *body.SiteID
+	}
+	if body.IP != nil {
+		if *body.IP == "" {
+			return errorf(http.StatusBadRequest, "ip must not be empty")
+		}
+		t.IP = *body.IP
+	}
+	if body.Port != nil {
+		if *body.Port < 1 || *body.Port > 65535 {
+			return errorf(http.StatusBadRequest, "Invalid port %d", *body.Port)
+		}
+		t.Port = *body.Port
+	}
+	if body.Method != nil {
+		method := *body.Method
+		t.Method = &method
+	}
+	if body.Enabled != nil {
+		t.Enabled = *body.Enabled
+	}
+	return nil
+}
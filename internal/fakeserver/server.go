@@ -0,0 +1,237 @@
+// Package fakeserver is an in-memory stand-in for the part of the Pangolin
+// integration API the provider uses: organizations, roles, users, domains,
+// sites, site resources, resources, targets, their role and user memberships,
+// invitations, blueprints, OIDC identity providers and the request audit log.
+//
+// Responses use Pangolin's {data, success, error, message, status} envelope
+// and the same status codes for missing objects, invalid bodies and conflicts,
+// so tests can run the provider and client against it without docker.
+package fakeserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Token is the bearer token the server accepts.
+const Token = "fake-token"
+
+// Server is a running fake Pangolin integration API. The zero value is not
+// usable; create one with New and stop it with Close.
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	ids           map[string]int
+	orgs          map[string]*Org
+	domains       map[string]*Domain
+	idps          map[int]*Idp
+	roles         map[int]*Role
+	users         map[string]*User
+	members       map[string]map[string]*member
+	sites         map[int]*Site
+	siteResources map[int]*SiteResource
+	resources     map[int]*Resource
+	targets       map[int]*Target
+	invitations   map[string]*Invitation
+	blueprints    map[int]*Blueprint
+	requestLogs   map[int]*RequestLog
+}
+
+// New starts a fake server with an empty store.
+func New() *Server {
+	s := &Server{
+		ids:           map[string]int{},
+		orgs:          map[string]*Org{},
+		domains:       map[string]*Domain{},
+		idps:          map[int]*Idp{},
+		roles:         map[int]*Role{},
+		users:         map[string]*User{},
+		members:       map[string]map[string]*member{},
+		sites:         map[int]*Site{},
+		siteResources: map[int]*SiteResource{},
+		resources:     map[int]*Resource{},
+		targets:       map[int]*Target{},
+		invitations:   map[string]*Invitation{},
+		blueprints:    map[int]*Blueprint{},
+		requestLogs:   map[int]*RequestLog{},
+	}
+
+	mux := http.NewServeMux()
+	s.handle(mux, "GET /{$}", func(*http.Request) (any, error) {
+		return "Healthy", nil
+	})
+	s.routeOrgs(mux)
+	s.routeSites(mux)
+	s.routeResources(mux)
+	s.routeDomains(mux)
+	s.routeIdps(mux)
+	s.routeInvitations(mux)
+	s.routeBlueprints(mux)
+	s.routeLogs(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, errorf(http.StatusNotFound, "%s %s not found", r.Method, r.URL.Path))
+	})
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	return s
+}
+
+// BaseURL returns the URL to configure as the provider's base_url.
+func (s *Server) BaseURL() string {
+	return s.URL + "/v1"
+}
+
+// authenticate rejects requests without the expected bearer token. The
+// health check is public, as in the spec.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		public := r.Method == http.MethodGet && r.URL.Path == "/v1/"
+		if !public && r.Header.Get("Authorization") != "Bearer "+Token {
+			writeError(w, errorf(http.StatusUnauthorized, "Key is not valid"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handle registers a handler under the /v1 prefix. Handlers run with the store
+// locked and return the data to wrap in the envelope, or an error.
+func (s *Server) handle(mux *http.ServeMux, pattern string, h func(r *http.Request) (any, error)) {
+	method, path, _ := strings.Cut(pattern, " ")
+	mux.HandleFunc(method+" /v1"+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		data, err := h(r)
+		s.mu.Unlock()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeEnvelope(w, http.StatusOK, data, "Request successful")
+	})
+}
+
+// nextID returns the next ID of a kind of object. IDs start at 1 per kind,
+// like Pangolin's autoincrement columns.
+func (s *Server) nextID(kind string) int {
+	s.ids[kind]++
+	return s.ids[kind]
+}
+
+type envelope struct {
+	Data    any    `json:"data"`
+	Success bool   `json:"success"`
+	Error   bool   `json:"error"`
+	Message string `json:"message"`
+	Status  int    `json:"status"`
+}
+
+func writeEnvelope(w http.ResponseWriter, status int, data any, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(envelope{
+		Data:    data,
+		Success: status < 400,
+		Error:   status >= 400,
+		Message: message,
+		Status:  status,
+	})
+}
+
+// apiError is an error answered with a specific status code.
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func errorf(status int, format string, args ...any) error {
+	return &apiError{status: status, message: fmt.Sprintf(format, args...)}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		apiErr = &apiError{status: http.StatusInternalServerError, message: err.Error()}
+	}
+	writeEnvelope(w, apiErr.status, nil, apiErr.message)
+}
+
+// decode reads a JSON request body into v.
+func decode(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return errorf(http.StatusBadRequest, "Invalid request body: %v", err)
+	}
+	return nil
+}
+
+// pathInt parses a numeric path parameter.
+func pathInt(r *http.Request, name string) (int, error) {
+	v, err := strconv.Atoi(r.PathValue(name))
+	if err != nil {
+		return 0, errorf(http.StatusBadRequest, "Invalid %s: %q", name, r.PathValue(name))
+	}
+	return v, nil
+}
+
+type pagination struct {
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// page applies the limit and offset query parameters of list endpoints.
+func page[T any](r *http.Request, items []T) ([]T, pagination, error) {
+	p := pagination{Total: len(items), Limit: 1000}
+	for name, dst := range map[string]*int{"limit": &p.Limit, "offset": &p.Offset} {
+		if v := r.URL.Query().Get(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				return nil, p, errorf(http.StatusBadRequest, "Invalid %s: %q", name, v)
+			}
+			*dst = n
+		}
+	}
+	start := min(p.Offset, len(items))
+	end := min(start+p.Limit, len(items))
+	return items[start:end], p, nil
+}
+
+// sortedByID returns the values of m ordered by key.
+func sortedByID[T any](m map[int]*T, keep func(*T) bool) []T {
+	ids := make([]int, 0, len(m))
+	for id, v := range m {
+		if keep(v) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	out := make([]T, len(ids))
+	for i, id := range ids {
+		out[i] = *m[id]
+	}
+	return out
+}
+
+var nonSlug = regexp.MustCompile(`[^a-z0-9]+`)
+
+// niceID derives a readable ID from a name. Pangolin picks random words; the
+// fake keeps them predictable.
+func niceID(name string, id int) string {
+	slug := strings.Trim(nonSlug.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if slug == "" {
+		slug = "object"
+	}
+	return fmt.Sprintf("%s-%d", slug, id)
+}
@@ -0,0 +1,530 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+)
+
+const testOrg = "org"
+
+func newTestServer(t *testing.T) (*Server, *client.Client) {
+	t.Helper()
+	s := New()
+	t.Cleanup(s.Close)
+	s.AddOrg(testOrg)
+	return s, client.NewClient(s.BaseURL(), Token)
+}
+
+func wantStatus(t *testing.T, err error, status int) {
+	t.Helper()
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != status {
+		t.Fatalf("expected API error %d, got %v", status, err)
+	}
+}
+
+func TestEnvelopeAndErrors(t *testing.T) {
+	s, c := newTestServer(t)
+
+	if err := c.Ping(t.Context()); err != nil {
+		t.Errorf("health check: %v", err)
+	}
+	// The health check is public; only authenticated endpoints reject a wrong token.
+	wrong := client.NewClient(s.BaseURL(), "wrong")
+	if err := wrong.Ping(t.Context()); err != nil {
+		t.Errorf("health check with a wrong token: %v", err)
+	}
+	if err := wrong.CheckToken(t.Context()); !errors.Is(err, client.ErrInvalidToken) {
+		t.Errorf("token check with a wrong token: %v", err)
+	}
+	if err := c.CheckToken(t.Context()); err != nil {
+		t.Errorf("token check: %v", err)
+	}
+	c.OrgID = testOrg
+	if err := c.CheckToken(t.Context()); err != nil {
+		t.Errorf("token check in the default organization: %v", err)
+	}
+	c.OrgID = ""
+
+	_, err := client.NewClient(s.BaseURL(), "wrong").ListRoles(t.Context(), testOrg)
+	wantStatus(t, err, http.StatusUnauthorized)
+
+	_, err = c.GetResource(t.Context(), 42)
+	if !client.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	req, _ := http.NewRequest("GET", s.BaseURL()+"/org/missing", nil)
+	req.Header.Set("Authorization", "Bearer "+Token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var env envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusNotFound || env.Success || !env.Error || env.Status != http.StatusNotFound || env.Message == "" {
+		t.Errorf("unexpected error envelope %d %+v", resp.StatusCode, env)
+	}
+}
+
+func TestRoles(t *testing.T) {
+	s, c := newTestServer(t)
+	member, _ := s.RoleByName(testOrg, "Member")
+
+	role, err := c.CreateRole(t.Context(), testOrg, &client.Role{Name: "Ops", Description: "Operators"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.CreateRole(t.Context(), testOrg, &client.Role{Name: "Ops"})
+	wantStatus(t, err, http.StatusBadRequest)
+
+	userID := s.AddUser(testOrg, "alice", role.ID)
+
+	if _, err := c.UpdateRole(t.Context(), testOrg, role.ID, &client.Role{Name: "Operations", Description: "Operators"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetRole(t.Context(), testOrg, role.ID)
+	if err != nil || got.Name != "Operations" || got.IsAdmin {
+		t.Fatalf("unexpected role %+v, %v", got, err)
+	}
+
+	// DeleteRole moves the role's users to the organization's Member role.
+	if err := c.DeleteRole(t.Context(), testOrg, role.ID); err != nil {
+		t.Fatal(err)
+	}
+	user, err := c.GetOrgUser(t.Context(), testOrg, userID)
+	if err != nil || user.RoleID != member.RoleID || user.RoleName != "Member" {
+		t.Fatalf("expected the user to move to Member, got %+v, %v", user, err)
+	}
+
+	admin, _ := s.RoleByName(testOrg, "Admin")
+	err = c.DeleteRole(t.Context(), testOrg, admin.RoleID)
+	wantStatus(t, err, http.StatusForbidden)
+
+	roles, err := c.ListRoles(t.Context(), testOrg)
+	if err != nil || len(roles) != 2 {
+		t.Fatalf("expected the two built-in roles, got %+v, %v", roles, err)
+	}
+}
+
+func TestDeleteRole_OtherOrg(t *testing.T) {
+	s, c := newTestServer(t)
+	s.AddOrg("other")
+	member, _ := s.RoleByName("other", "Member")
+
+	role, err := c.CreateRole(t.Context(), "other", &client.Role{Name: "Ops"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	userID := s.AddUser("other", "carol", role.ID)
+
+	if err := c.DeleteRole(t.Context(), "other", role.ID); err != nil {
+		t.Fatal(err)
+	}
+	user, err := c.GetOrgUser(t.Context(), "other", userID)
+	if err != nil || user.RoleID != member.RoleID {
+		t.Fatalf("expected the user to move to role %d, got %+v, %v", member.RoleID, user, err)
+	}
+}
+
+func TestOrgUsers(t *testing.T) {
+	s, c := newTestServer(t)
+	admin, _ := s.RoleByName(testOrg, "Admin")
+	member, _ := s.RoleByName(testOrg, "Member")
+
+	user, err := c.CreateOrgUser(t.Context(), testOrg, &client.OrgUser{Username: "bob", Type: "oidc", IdpID: 1, RoleID: member.RoleID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.CreateOrgUser(t.Context(), testOrg, &client.OrgUser{Username: "bob", Type: "oidc", IdpID: 1, RoleID: member.RoleID})
+	wantStatus(t, err, http.StatusConflict)
+
+	if err := c.AddRoleToUser(t.Context(), admin.RoleID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	allowed, err := c.CheckOrgUserAccess(t.Context(), testOrg, user.ID)
+	if err != nil || !allowed {
+		t.Fatalf("expected access, got %v, %v", allowed, err)
+	}
+	users, err := c.ListOrgUsers(t.Context(), testOrg)
+	if err != nil || len(users) != 1 || users[0].ID != user.ID || users[0].RoleID != admin.RoleID {
+		t.Fatalf("unexpected users %+v, %v", users, err)
+	}
+
+	if err := c.SetUserTwoFactorSetupRequested(t.Context(), user.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	if u, err := c.GetUser(t.Context(), user.ID); err != nil || !u.TwoFactorSetupRequested {
+		t.Fatalf("expected 2FA setup to be requested, got %+v, %v", u, err)
+	}
+
+	if err := c.DeleteOrgUser(t.Context(), testOrg, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetOrgUser(t.Context(), testOrg, user.ID); !client.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestSiteResources(t *testing.T) {
+	s, c := newTestServer(t)
+	siteID := s.AddSite(testOrg, "Main Site")
+	admin, _ := s.RoleByName(testOrg, "Admin")
+	member, _ := s.RoleByName(testOrg, "Member")
+	userID := s.AddUser(testOrg, "carol", member.RoleID)
+
+	alias := "db.example.internal"
+	res, err := c.CreateSiteResource(t.Context(), testOrg, &client.SiteResource{
+		Name: "Database", Mode: "host", SiteID: siteID, Destination: "10.0.0.5", Enabled: true, Alias: &alias,
+		UserIDs: []string{userID}, RoleIDs: []int{member.RoleID}, ClientIDs: []int{7},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.NiceID == "" || res.SiteID != siteID || res.TCPPortRangeString != "*" {
+		t.Fatalf("unexpected site resource %+v", res)
+	}
+
+	roles, _ := c.GetSiteResourceRoles(t.Context(), res.ID)
+	if !slices.Equal(roles, []int{admin.RoleID, member.RoleID}) {
+		t.Errorf("expected the admin role to be granted too, got %v", roles)
+	}
+	users, _ := c.GetSiteResourceUsers(t.Context(), res.ID)
+	clients, _ := c.GetSiteResourceClients(t.Context(), res.ID)
+	if !slices.Equal(users, []string{userID}) || !slices.Equal(clients, []int{7}) {
+		t.Errorf("unexpected grants: users %v, clients %v", users, clients)
+	}
+
+	_, err = c.CreateSiteResource(t.Context(), testOrg, &client.SiteResource{Name: "Bad", Mode: "tunnel", SiteID: siteID, Destination: "x"})
+	wantStatus(t, err, http.StatusBadRequest)
+	_, err = c.CreateSiteResource(t.Context(), testOrg, &client.SiteResource{Name: "Lost", Mode: "host", SiteID: 99, Destination: "x"})
+	wantStatus(t, err, http.StatusNotFound)
+
+	if _, err := c.UpdateSiteResource(t.Context(), res.ID, &client.SiteResource{
+		Name: "Database", Mode: "cidr", SiteID: siteID, Destination: "10.0.0.0/24", Enabled: false,
+		UserIDs: []string{}, RoleIDs: []int{}, ClientIDs: []int{},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetSiteResource(t.Context(), testOrg, siteID, res.ID)
+	if err != nil || got.Mode != "cidr" || got.Enabled || got.Alias == nil || *got.Alias != alias {
+		t.Fatalf("unexpected site resource %+v, %v", got, err)
+	}
+	if users, _ := c.GetSiteResourceUsers(t.Context(), res.ID); len(users) != 0 {
+		t.Errorf("expected no users, got %v", users)
+	}
+
+	bySite, err := c.ListSiteResourcesBySite(t.Context(), testOrg, siteID)
+	if err != nil || len(bySite) != 1 {
+		t.Fatalf("unexpected site resources %+v, %v", bySite, err)
+	}
+
+	if err := c.DeleteSiteResource(t.Context(), res.ID); err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := c.ListSiteResources(t.Context(), testOrg); len(all) != 0 {
+		t.Errorf("expected no site resources, got %+v", all)
+	}
+}
+
+func TestResourcesAndTargets(t *testing.T) {
+	s, c := newTestServer(t)
+	s.AddDomain(testOrg, "example", "example.com")
+	siteID := s.AddSite(testOrg, "Main Site")
+
+	res, err := c.CreateResource(t.Context(), testOrg, &client.Resource{Name: "App", Protocol: "tcp", Http: true, Subdomain: "app", DomainID: "example"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.FullDomain != "app.example.com" || res.NiceID == "" || res.SSO == nil || !*res.SSO {
+		t.Fatalf("unexpected resource %+v", res)
+	}
+
+	_, err = c.CreateResource(t.Context(), testOrg, &client.Resource{Name: "Dup", Protocol: "tcp", Http: true, Subdomain: "app", DomainID: "example"})
+	wantStatus(t, err, http.StatusConflict)
+	_, err = c.CreateResource(t.Context(), testOrg, &client.Resource{Name: "Nowhere", Protocol: "tcp", Http: true, Subdomain: "x", DomainID: "missing"})
+	wantStatus(t, err, http.StatusNotFound)
+
+	if _, err := c.UpdateResource(t.Context(), res.ID, &client.Resource{Name: "App", Protocol: "tcp", Http: true, Subdomain: "www", DomainID: "example"}); err != nil {
+		t.Fatal(err)
+	}
+	byNice, err := c.GetResourceByNiceID(t.Context(), testOrg, res.NiceID)
+	if err != nil || byNice.ID != res.ID || byNice.FullDomain != "www.example.com" {
+		t.Fatalf("unexpected resource %+v, %v", byNice, err)
+	}
+
+	target, err := c.CreateTarget(t.Context(), res.ID, &client.Target{SiteID: siteID, IP: "10.0.0.1", Port: 80, Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.UpdateTarget(t.Context(), target.ID, &client.Target{SiteID: siteID, IP: "10.0.0.2", Port: 8080, Enabled: true}); err != nil {
+		t.Fatal(err)
+	}
+	targets, err := c.ListTargets(t.Context(), res.ID)
+	if err != nil || len(targets) != 1 || targets[0].IP != "10.0.0.2" || targets[0].Port != 8080 {
+		t.Fatalf("unexpected targets %+v, %v", targets, err)
+	}
+	_, err = c.CreateTarget(t.Context(), res.ID, &client.Target{SiteID: siteID, IP: "10.0.0.3", Port: 70000})
+	wantStatus(t, err, http.StatusBadRequest)
+
+	if err := c.DeleteResource(t.Context(), res.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetTarget(t.Context(), target.ID); !client.IsNotFound(err) {
+		t.Fatalf("expected the target to be deleted with its resource, got %v", err)
+	}
+}
+
+func TestDomains(t *testing.T) {
+	s, c := newTestServer(t)
+	s.AddDomain(testOrg, "example", "example.com")
+	s.AddOrg("other")
+	s.AddDomain("other", "elsewhere", "example.net")
+
+	domains, err := c.ListDomains(t.Context(), testOrg)
+	if err != nil || len(domains) != 1 || domains[0].ID != "example" || !domains[0].Verified {
+		t.Fatalf("unexpected domains %+v, %v", domains, err)
+	}
+	domain, err := c.GetDomain(t.Context(), testOrg, "example")
+	if err != nil || domain.BaseDomain != "example.com" {
+		t.Fatalf("unexpected domain %+v, %v", domain, err)
+	}
+	if _, err := c.GetDomain(t.Context(), testOrg, "elsewhere"); !client.IsNotFound(err) {
+		t.Fatalf("expected the domain of another organization to be not found, got %v", err)
+	}
+	records, err := c.GetDomainDNSRecords(t.Context(), testOrg, "example")
+	if err != nil || len(records) != 1 || records[0].BaseDomain != "*.example.com" {
+		t.Fatalf("unexpected records %+v, %v", records, err)
+	}
+}
+
+func TestIdps(t *testing.T) {
+	s, c := newTestServer(t)
+
+	idp := &client.OIDCIdp{Name: "Keycloak", ClientID: "pangolin", ClientSecret: "secret", AuthURL: "https://idp/auth", TokenURL: "https://idp/token", IdentifierPath: "sub", Scopes: "openid"}
+	created, err := c.CreateOIDCIdp(t.Context(), idp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == 0 || created.RedirectURL == "" {
+		t.Fatalf("unexpected IdP %+v", created)
+	}
+	_, err = c.CreateOIDCIdp(t.Context(), &client.OIDCIdp{Name: "No Secret", ClientID: "x", AuthURL: "a", TokenURL: "t", IdentifierPath: "sub", Scopes: "openid"})
+	wantStatus(t, err, http.StatusBadRequest)
+
+	// An empty secret leaves the stored one alone.
+	idp.Name = "Keycloak 2"
+	idp.ClientSecret = ""
+	if err := c.UpdateOIDCIdp(t.Context(), created.ID, idp); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetOIDCIdp(t.Context(), created.ID)
+	if err != nil || got.Name != "Keycloak 2" || got.ClientID != "pangolin" || got.RedirectURL != created.RedirectURL {
+		t.Fatalf("unexpected IdP %+v, %v", got, err)
+	}
+	if secret, _ := s.IdpClientSecret(created.ID); secret != "secret" {
+		t.Errorf("expected the secret to be kept, got %q", secret)
+	}
+
+	if err := c.DeleteIdp(t.Context(), created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetOIDCIdp(t.Context(), created.ID); !client.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestInvitations(t *testing.T) {
+	s, c := newTestServer(t)
+	member, _ := s.RoleByName(testOrg, "Member")
+
+	first, err := c.CreateInvitation(t.Context(), testOrg, "new@example.com", member.RoleID, 24, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID == "" || first.RoleID != member.RoleID || first.InviteLink == "" || first.ExpiresAt <= time.Now().UnixMilli() {
+		t.Fatalf("unexpected invitation %+v", first)
+	}
+
+	// CreateInvitation regenerates the open invitation of the same email.
+	second, err := c.CreateInvitation(t.Context(), testOrg, "NEW@example.com", member.RoleID, 48, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.ID != first.ID || second.InviteLink == first.InviteLink || second.ExpiresAt <= first.ExpiresAt {
+		t.Fatalf("expected %+v to be regenerated, got %+v", first, second)
+	}
+
+	_, err = c.CreateInvitation(t.Context(), testOrg, "other@example.com", member.RoleID, 200, false)
+	wantStatus(t, err, http.StatusBadRequest)
+	s.AddOrg("other")
+	otherRole, _ := s.RoleByName("other", "Member")
+	_, err = c.CreateInvitation(t.Context(), testOrg, "other@example.com", otherRole.RoleID, 24, false)
+	wantStatus(t, err, http.StatusBadRequest)
+
+	invitations, err := c.ListInvitations(t.Context(), testOrg)
+	if err != nil || len(invitations) != 1 || invitations[0].InviteLink != "" {
+		t.Fatalf("unexpected invitations %+v, %v", invitations, err)
+	}
+
+	if err := c.DeleteInvitation(t.Context(), testOrg, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	err = c.DeleteInvitation(t.Context(), testOrg, first.ID)
+	wantStatus(t, err, http.StatusNotFound)
+}
+
+func TestBlueprints(t *testing.T) {
+	s, c := newTestServer(t)
+	siteID := s.AddSite(testOrg, "Main")
+	site, err := c.GetSite(t.Context(), siteID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applied, err := c.ApplyBlueprint(t.Context(), testOrg, fmt.Appendf(nil, `{"proxy-resources":{"web":{"targets":[{"site":%q,"port":80}]}}}`, site.NiceID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !applied.Succeeded || applied.ID == 0 || applied.Message == "" {
+		t.Fatalf("unexpected blueprint %+v", applied)
+	}
+
+	// An unknown site fails the apply, which is still recorded.
+	failed, err := c.ApplyBlueprint(t.Context(), testOrg, []byte(`{"proxy-resources":{"web":{"targets":[{"site":"nowhere","port":80}]}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failed.Succeeded || failed.ID == applied.ID || !strings.Contains(failed.Message, "nowhere") {
+		t.Fatalf("unexpected blueprint %+v", failed)
+	}
+	got, err := c.GetBlueprint(t.Context(), testOrg, failed.ID)
+	if err != nil || got.Succeeded || got.Message != failed.Message {
+		t.Fatalf("unexpected blueprint %+v, %v", got, err)
+	}
+
+	_, err = c.ApplyBlueprint(t.Context(), testOrg, []byte(`[]`))
+	wantStatus(t, err, http.StatusBadRequest)
+
+	blueprints, err := c.ListBlueprints(t.Context(), testOrg)
+	if err != nil || len(blueprints) != 2 {
+		t.Fatalf("expected 2 blueprints, got %+v, %v", blueprints, err)
+	}
+	s.AddOrg("other")
+	_, err = c.GetBlueprint(t.Context(), "other", applied.ID)
+	wantStatus(t, err, http.StatusNotFound)
+}
+
+func TestPagination(t *testing.T) {
+	s, c := newTestServer(t)
+	for _, name := range []string{"a", "b", "c"} {
+		s.AddSite(testOrg, name)
+	}
+
+	req, _ := http.NewRequest("GET", s.BaseURL()+"/org/"+testOrg+"/sites?limit=1&offset=1", nil)
+	req.Header.Set("Authorization", "Bearer "+Token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var env struct {
+		Data struct {
+			Sites      []Site     `json:"sites"`
+			Pagination pagination `json:"pagination"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatal(err)
+	}
+	if len(env.Data.Sites) != 1 || env.Data.Sites[0].Name != "b" || env.Data.Pagination.Total != 3 {
+		t.Errorf("unexpected page %+v", env.Data)
+	}
+
+	sites, err := c.ListSites(t.Context(), testOrg)
+	if err != nil || len(sites) != 3 {
+		t.Fatalf("expected 3 sites, got %+v, %v", sites, err)
+	}
+}
+
+func TestRequestLogs(t *testing.T) {
+	s, c := newTestServer(t)
+	start := time.Date(2025, 6, 1, 23, 55, 0, 0, time.UTC)
+	de, us := "DE", "US"
+	// Three entries per second over more than one page, every third blocked,
+	// crossing midnight.
+	const total = 1500
+	for i := range total {
+		location := &de
+		if i%2 == 1 {
+			location = &us
+		}
+		s.AddRequestLog(testOrg, RequestLog{Timestamp: start.Unix() + int64(i/3), Action: i%3 != 0, Location: location})
+	}
+	query := client.RequestLogQuery{TimeStart: start, TimeEnd: start.Add(time.Hour)}
+
+	logs, err := c.ListRequestLogs(t.Context(), testOrg, query, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != total {
+		t.Fatalf("expected %d entries across pages, got %d", total, len(logs))
+	}
+	seen := map[int]bool{}
+	for i, l := range logs {
+		if seen[l.ID] || (i > 0 && l.Timestamp > logs[i-1].Timestamp) {
+			t.Fatalf("entry %d is duplicated or out of order: %+v", i, l)
+		}
+		seen[l.ID] = true
+	}
+
+	logs, err = c.ListRequestLogs(t.Context(), testOrg, query, 10)
+	if err != nil || len(logs) != 10 || logs[0].Timestamp != start.Unix()+(total-1)/3 {
+		t.Fatalf("unexpected capped entries %+v, %v", logs, err)
+	}
+
+	blocked := false
+	query.Action = &blocked
+	logs, err = c.ListRequestLogs(t.Context(), testOrg, query, 0)
+	if err != nil || len(logs) != total/3 {
+		t.Fatalf("expected %d blocked entries, got %d, %v", total/3, len(logs), err)
+	}
+
+	analytics, err := c.GetRequestAnalytics(t.Context(), testOrg, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if analytics.TotalRequests != total || analytics.TotalBlocked != total/3 {
+		t.Errorf("unexpected totals %+v", analytics)
+	}
+	if len(analytics.RequestsPerDay) != 2 || analytics.RequestsPerDay[0].Day != "2025-06-01" ||
+		analytics.RequestsPerDay[0].TotalCount+analytics.RequestsPerDay[1].TotalCount != total {
+		t.Errorf("unexpected days %+v", analytics.RequestsPerDay)
+	}
+	if len(analytics.RequestsPerCountry) != 2 || analytics.RequestsPerCountry[0] != (client.CountryRequestCount{Code: "DE", Count: total / 2}) {
+		t.Errorf("unexpected countries %+v", analytics.RequestsPerCountry)
+	}
+
+	// More entries than fit in a page in one second cannot be paged.
+	s.AddOrg("crowded")
+	for range requestLogPageSize + 1 {
+		s.AddRequestLog("crowded", RequestLog{Timestamp: start.Unix(), Action: true})
+	}
+	if _, err := c.ListRequestLogs(t.Context(), "crowded", client.RequestLogQuery{TimeStart: start}, 0); err == nil || !strings.Contains(err.Error(), "cannot be paged") {
+		t.Errorf("expected a paging error, got %v", err)
+	}
+
+	_, err = c.GetRequestAnalytics(t.Context(), "missing", client.RequestLogQuery{})
+	wantStatus(t, err, http.StatusNotFound)
+}
@@ -0,0 +1,344 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Site is a site, the far end of a tunnel.
+type Site struct {
+	SiteID              int     `json:"siteId"`
+	NiceID              string  `json:"niceId"`
+	OrgID               string  `json:"orgId"`
+	Name                string  `json:"name"`
+	Type                string  `json:"type"`
+	Online              bool    `json:"online"`
+	Subnet              *string `json:"subnet"`
+	Address             *string `json:"address"`
+	MegabytesIn         float64 `json:"megabytesIn"`
+	MegabytesOut        float64 `json:"megabytesOut"`
+	LastBandwidthUpdate *string `json:"lastBandwidthUpdate"`
+	DockerSocketEnabled bool    `json:"dockerSocketEnabled"`
+}
+
+// SiteResource is a private resource reached through a site.
+type SiteResource struct {
+	SiteResourceID     int     `json:"siteResourceId"`
+	NiceID             string  `json:"niceId"`
+	OrgID              string  `json:"orgId"`
+	SiteID             int     `json:"siteId"`
+	Name               string  `json:"name"`
+	Mode               string  `json:"mode"`
+	Destination        string  `json:"destination"`
+	Enabled            bool    `json:"enabled"`
+	Alias              *string `json:"alias"`
+	TCPPortRangeString string  `json:"tcpPortRangeString"`
+	UDPPortRangeString string  `json:"udpPortRangeString"`
+	DisableIcmp        bool    `json:"disableIcmp"`
+
+	RoleIDs   []int    `json:"-"`
+	UserIDs   []string `json:"-"`
+	ClientIDs []int    `json:"-"`
+}
+
+// AddSite creates an online newt site and returns its ID. It panics if the
+// organization does not exist.
+func (s *Server) AddSite(orgID string, name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.orgs[orgID]; !ok {
+		panic(fmt.Sprintf("fakeserver: organization %q does not exist", orgID))
+	}
+	return s.insertSite(orgID, name, "newt", true).SiteID
+}
+
+func (s *Server) insertSite(orgID string, name string, siteType string, online bool) *Site {
+	id := s.nextID("site")
+	subnet := fmt.Sprintf("100.89.%d.0/29", id)
+	site := &Site{SiteID: id, NiceID: niceID(name, id), OrgID: orgID, Name: name, Type: siteType, Online: online, Subnet: &subnet}
+	s.sites[id] = site
+	return site
+}
+
+func (s *Server) routeSites(mux *http.ServeMux) {
+	s.handle(mux, "PUT /org/{orgId}/site", s.createSite)
+	s.handle(mux, "GET /org/{orgId}/sites", func(r *http.Request) (any, error) {
+		o, err := s.org(r.PathValue("orgId"))
+		if err != nil {
+			return nil, err
+		}
+		sites, p, err := page(r, sortedByID(s.sites, func(site *Site) bool { return site.OrgID == o.OrgID }))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"sites": sites, "pagination": p}, nil
+	})
+	s.handle(mux, "GET /site/{siteId}", func(r *http.Request) (any, error) {
+		id, err := pathInt(r, "siteId")
+		if err != nil {
+			return nil, err
+		}
+		return s.site(id)
+	})
+	s.handle(mux, "GET /org/{orgId}/site/{niceId}", func(r *http.Request) (any, error) {
+		for _, site := range s.sites {
+			if site.OrgID == r.PathValue("orgId") && site.NiceID == r.PathValue("niceId") {
+				return site, nil
+			}
+		}
+		return nil, errorf(http.StatusNotFound, "Site with niceId %s not found", r.PathValue("niceId"))
+	})
+
+	// The bundled spec documents site-resource; the client uses the
+	// private-resource name of current Pangolin releases.
+	s.handle(mux, "PUT /org/{orgId}/site-resource", s.createSiteResource)
+	s.handle(mux, "PUT /org/{orgId}/private-resource", s.createSiteResource)
+	s.handle(mux, "GET /site-resource/{siteResourceId}", func(r *http.Request) (any, error) {
+		return s.siteResourceFromPath(r)
+	})
+	s.handle(mux, "POST /site-resource/{siteResourceId}", s.updateSiteResource)
+	s.handle(mux, "DELETE /site-resource/{siteResourceId}", func(r *http.Request) (any, error) {
+		res, err := s.siteResourceFromPath(r)
+		if err != nil {
+			return nil, err
+		}
+		delete(s.siteResources, res.SiteResourceID)
+		return nil, nil
+	})
+	s.handle(mux, "GET /org/{orgId}/site-resources", func(r *http.Request) (any, error) {
+		return s.listSiteResources(r, 0)
+	})
+	s.handle(mux, "GET /org/{orgId}/site/{siteId}/resources", func(r *http.Request) (any, error) {
+		siteID, err := pathInt(r, "siteId")
+		if err != nil {
+			return nil, err
+		}
+		return s.listSiteResources(r, siteID)
+	})
+	s.handle(mux, "GET /org/{orgId}/site/{siteId}/resource/nice/{niceId}", func(r *http.Request) (any, error) {
+		siteID, err := pathInt(r, "siteId")
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range s.siteResources {
+			if res.OrgID == r.PathValue("orgId") && res.SiteID == siteID && res.NiceID == r.PathValue("niceId") {
+				return res, nil
+			}
+		}
+		return nil, errorf(http.StatusNotFound, "Site resource with niceId %s not found", r.PathValue("niceId"))
+	})
+
+	lookup := func(r *http.Request) (grants, error) {
+		res, err := s.siteResourceFromPath(r)
+		if err != nil {
+			return grants{}, err
+		}
+		return grants{orgID: res.OrgID, roleIDs: &res.RoleIDs, userIDs: &res.UserIDs, clientIDs: &res.ClientIDs}, nil
+	}
+	s.routeGrants(mux, "/site-resource/{siteResourceId}", lookup)
+	s.routeClientGrants(mux, "/site-resource/{siteResourceId}", lookup)
+}
+
+func (s *Server) site(siteID int) (*Site, error) {
+	site, ok := s.sites[siteID]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Site with ID %d not found", siteID)
+	}
+	return site, nil
+}
+
+// orgSite returns a site that must belong to the organization.
+func (s *Server) orgSite(orgID string, siteID int) (*Site, error) {
+	site, err := s.site(siteID)
+	if err != nil {
+		return nil, err
+	}
+	if site.OrgID != orgID {
+		return nil, errorf(http.StatusNotFound, "Site with ID %d not found in organization %s", siteID, orgID)
+	}
+	return site, nil
+}
+
+func (s *Server) createSite(r *http.Request) (any, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	var body struct {
+		Name   string `json:"name"`
+		Type   string `json:"type"`
+		NewtID string `json:"newtId"`
+		Secret string `json:"secret"`
+	}
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	if body.Name == "" {
+		return nil, errorf(http.StatusBadRequest, "name is required")
+	}
+	switch body.Type {
+	case "newt":
+		if body.NewtID == "" || body.Secret == "" {
+			return nil, errorf(http.StatusBadRequest, "newtId and secret are required for newt sites")
+		}
+	case "wireguard", "local":
+	default:
+		return nil, errorf(http.StatusBadRequest, "Invalid site type %q", body.Type)
+	}
+	return s.insertSite(o.OrgID, body.Name, body.Type, false), nil
+}
+
+func (s *Server) siteResourceFromPath(r *http.Request) (*SiteResource, error) {
+	id, err := pathInt(r, "siteResourceId")
+	if err != nil {
+		return nil, err
+	}
+	res, ok := s.siteResources[id]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Site resource with ID %d not found", id)
+	}
+	return res, nil
+}
+
+// siteResourceBody is the create and update body of site resources. Every
+// field is optional here; create checks the required ones.
+type siteResourceBody struct {
+	Name               *string   `json:"name"`
+	Mode               *string   `json:"mode"`
+	SiteID             *int      `json:"siteId"`
+	Destination        *string   `json:"destination"`
+	Enabled            *bool     `json:"enabled"`
+	Alias              *string   `json:"alias"`
+	UserIDs            *[]string `json:"userIds"`
+	RoleIDs            *[]int    `json:"roleIds"`
+	ClientIDs          *[]int    `json:"clientIds"`
+	TCPPortRangeString *string   `json:"tcpPortRangeString"`
+	UDPPortRangeString *string   `json:"udpPortRangeString"`
+	DisableIcmp        *bool     `json:"disableIcmp"`
+}
+
+func (s *Server) createSiteResource(r *http.Request) (any, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	var body siteResourceBody
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || body.Mode == nil || body.SiteID == nil || body.Destination == nil {
+		return nil, errorf(http.StatusBadRequest, "name, mode, siteId and destination are required")
+	}
+
+	res := &SiteResource{OrgID: o.OrgID, Enabled: true, TCPPortRangeString: "*", UDPPortRangeString: "*"}
+	if err := s.applySiteResource(res, body); err != nil {
+		return nil, err
+	}
+	res.SiteResourceID = s.nextID("siteResource")
+	res.NiceID = niceID(res.Name, res.SiteResourceID)
+	s.siteResources[res.SiteResourceID] = res
+	return res, nil
+}
+
+func (s *Server) updateSiteResource(r *http.Request) (any, error) {
+	res, err := s.siteResourceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+	var body siteResourceBody
+	if err := decode(r, &body); err != nil {
+		return nil, err
+	}
+	updated := *res
+	if err := s.applySiteResource(&updated, body); err != nil {
+		return nil, err
+	}
+	*res = updated
+	return res, nil
+}
+
+// applySiteResource validates body and copies the fields it sets onto res.
+func (s *Server) applySiteResource(res *SiteResource, body siteResourceBody) error {
+	if body.SiteID != nil {
+		if _, err := s.orgSite(res.OrgID, *body.SiteID); err != nil {
+			return err
+		}
+		res.SiteID = *body.SiteID
+	}
+	if body.Mode != nil {
+		switch *body.Mode {
+		case "host", "cidr", "port":
+		default:
+			return errorf(http.StatusBadRequest, "Invalid mode %q", *body.Mode)
+		}
+		res.Mode = *body.Mode
+	}
+	if body.Name != nil {
+		if *body.Name == "" {
+			return errorf(http.StatusBadRequest, "name must not be empty")
+		}
+		res.Name = *body.Name
+	}
+	if body.Destination != nil {
+		if *body.Destination == "" {
+			return errorf(http.StatusBadRequest, "destination must not be empty")
+		}
+		res.Destination = *body.Destination
+	}
+	if body.Enabled != nil {
+		res.Enabled = *body.Enabled
+	}
+	if body.Alias != nil {
+		alias := *body.Alias
+		res.Alias = &alias
+	}
+	if body.TCPPortRangeString != nil {
+		res.TCPPortRangeString = *body.TCPPortRangeString
+	}
+	if body.UDPPortRangeString != nil {
+		res.UDPPortRangeString = *body.UDPPortRangeString
+	}
+	if body.DisableIcmp != nil {
+		res.DisableIcmp = *body.DisableIcmp
+	}
+
+	g := grants{orgID: res.OrgID, roleIDs: &res.RoleIDs, userIDs: &res.UserIDs, clientIDs: &res.ClientIDs}
+	if body.RoleIDs != nil || res.RoleIDs == nil {
+		var roleIDs []int
+		if body.RoleIDs != nil {
+			roleIDs = *body.RoleIDs
+		}
+		if err := s.setRoles(g, roleIDs); err != nil {
+			return err
+		}
+	}
+	if body.UserIDs != nil {
+		if err := s.setUsers(g, *body.UserIDs); err != nil {
+			return err
+		}
+	}
+	if body.ClientIDs != nil {
+		res.ClientIDs = uniq(*body.ClientIDs)
+	}
+	return nil
+}
+
+func (s *Server) listSiteResources(r *http.Request, siteID int) (any, error) {
+	o, err := s.org(r.PathValue("orgId"))
+	if err != nil {
+		return nil, err
+	}
+	if siteID != 0 {
+		if _, err := s.orgSite(o.OrgID, siteID); err != nil {
+			return nil, err
+		}
+	}
+	resources, p, err := page(r, sortedByID(s.siteResources, func(res *SiteResource) bool {
+		return res.OrgID == o.OrgID && (siteID == 0 || res.SiteID == siteID)
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"siteResources": resources, "pagination": p}, nil
+}
@@ -0,0 +1,30 @@
+// Command openapi-gen generates the typed Pangolin API client from the
+// bundled OpenAPI document. It is run through go generate in internal/client.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/openapi"
+)
+
+func main() {
+	specPath := flag.String("spec", openapi.DefaultPath, "path of the OpenAPI document")
+	out := flag.String("out", "api_gen.go", "output file")
+	pkg := flag.String("package", "client", "package name of the generated file")
+	flag.Parse()
+
+	spec, err := openapi.Load(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := openapi.Generate(spec, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
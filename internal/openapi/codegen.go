@@ -0,0 +1,556 @@
+package openapi
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Generate renders the Go source of a typed client for every operation in the
+// spec: one method per operation on type API, a struct per request body and
+// query string, and a string type per enum. The document declares no response
+// schemas, so operations return the raw data of the response envelope.
+//
+// The generated code expects the package to provide:
+//
+//	type API struct{ client *Client }
+//	func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error)
+//	func bodyOf[T any](body *T) interface{}
+func Generate(spec *Spec, pkg string) ([]byte, error) {
+	g := &generator{spec: spec, enums: map[string]*enumType{}}
+	g.collect()
+
+	var body bytes.Buffer
+	g.writeEnums(&body)
+	for _, op := range g.ops {
+		g.writeOperation(&body, op)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by openapi-gen from %s. DO NOT EDIT.\n\n", DefaultPath)
+	fmt.Fprintf(&buf, "package %s\n\nimport (\n", pkg)
+	for _, imp := range []string{"context", "encoding/json", "net/url", "strconv"} {
+		if bytes.Contains(body.Bytes(), []byte(imp[strings.LastIndex(imp, "/")+1:]+".")) {
+			fmt.Fprintf(&buf, "\t%q\n", imp)
+		}
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
+
+type generator struct {
+	spec    *Spec
+	ops     []*operation
+	enums   map[string]*enumType
+	pending []pendingStruct
+	emitted map[string]bool
+}
+
+type operation struct {
+	name     string
+	method   string
+	path     string
+	noun     string
+	op       *Operation
+	segments []segment
+	query    []Parameter
+	body     *Schema
+}
+
+type segment struct {
+	literal string
+	param   string
+	goName  string
+	numeric bool
+}
+
+type enumType struct {
+	name   string
+	values []string
+	uses   []string
+}
+
+type pendingStruct struct {
+	name   string
+	doc    string
+	schema *Schema
+	noun   string
+}
+
+var methodOrder = map[string]int{"get": 0, "put": 1, "post": 2, "delete": 3}
+
+// collect builds the operation list in a stable order, names operations and
+// registers every enum.
+func (g *generator) collect() {
+	for _, path := range sortedKeys(g.spec.Paths) {
+		methods := sortedKeys(g.spec.Paths[path])
+		sort.SliceStable(methods, func(i, j int) bool { return methodOrder[methods[i]] < methodOrder[methods[j]] })
+		for _, method := range methods {
+			g.ops = append(g.ops, newOperation(method, path, g.spec.Paths[path][method]))
+		}
+	}
+
+	// The document types the same path parameter as a number on some
+	// operations and a string on others; an ID numeric anywhere is an int
+	// everywhere so callers always pass the same type.
+	numeric := map[string]bool{}
+	for _, op := range g.ops {
+		for _, s := range op.segments {
+			numeric[s.param] = numeric[s.param] || s.numeric
+		}
+	}
+	for _, op := range g.ops {
+		for i := range op.segments {
+			op.segments[i].numeric = numeric[op.segments[i].param]
+		}
+	}
+
+	// Operations are named after the method and the literal path segments;
+	// the few that collide are told apart by their last path parameter.
+	count := map[string]int{}
+	for _, op := range g.ops {
+		count[op.name]++
+	}
+	for _, op := range g.ops {
+		if count[op.name] > 1 {
+			for i := len(op.segments) - 1; i >= 0; i-- {
+				if op.segments[i].param != "" {
+					op.name += "By" + goName(op.segments[i].param)
+					break
+				}
+			}
+		}
+	}
+
+	for _, op := range g.ops {
+		use := strings.ToUpper(op.method) + " " + op.path
+		for _, p := range op.query {
+			g.registerEnum(op.noun, p.Name, p.Schema, use)
+		}
+		g.walkEnums(op.noun, "", op.body, use)
+	}
+}
+
+func newOperation(method, path string, op *Operation) *operation {
+	o := &operation{method: method, path: path, op: op, body: op.RequestBody.JSONSchema()}
+
+	paramTypes := map[string]*Schema{}
+	for _, p := range op.Parameters {
+		switch p.In {
+		case "path":
+			paramTypes[p.Name] = p.Schema
+		case "query":
+			o.query = append(o.query, p)
+		}
+	}
+
+	name := []string{goName(method)}
+	for _, part := range strings.Split(strings.Trim(path, "/"), "/") {
+		if part == "" {
+			continue
+		}
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			param := part[1 : len(part)-1]
+			s := paramTypes[param]
+			o.segments = append(o.segments, segment{
+				param:   param,
+				goName:  paramName(param),
+				numeric: s != nil && (s.Type == "number" || s.Type == "integer"),
+			})
+			continue
+		}
+		o.segments = append(o.segments, segment{literal: part})
+		name = append(name, goName(part))
+		o.noun = singular(goName(part))
+	}
+	if len(name) == 1 {
+		name = append(name, "Root")
+	}
+	o.name = strings.Join(name, "")
+	return o
+}
+
+func (g *generator) walkEnums(noun, prop string, s *Schema, use string) {
+	s = flatten(s)
+	if s == nil {
+		return
+	}
+	if prop != "" {
+		g.registerEnum(noun, prop, s, use)
+	}
+	for _, name := range sortedKeys(s.Properties) {
+		g.walkEnums(noun, name, s.Properties[name], use)
+	}
+	if s.Items != nil {
+		g.walkEnums(noun, prop, s.Items, use)
+	}
+}
+
+func (g *generator) registerEnum(noun, prop string, s *Schema, use string) {
+	values := enumValues(s)
+	if len(values) == 0 {
+		return
+	}
+	name := noun + goName(prop)
+	e, ok := g.enums[name]
+	if !ok {
+		e = &enumType{name: name}
+		g.enums[name] = e
+	}
+	for _, v := range values {
+		if !contains(e.values, v) {
+			e.values = append(e.values, v)
+		}
+	}
+	if !contains(e.uses, use) {
+		e.uses = append(e.uses, use)
+	}
+}
+
+func enumValues(s *Schema) []string {
+	s = flatten(s)
+	if s == nil || s.Type != "string" {
+		return nil
+	}
+	var values []string
+	for _, v := range s.Enum {
+		if v != nil {
+			values = append(values, fmt.Sprint(v))
+		}
+	}
+	return values
+}
+
+func (g *generator) writeEnums(buf *bytes.Buffer) {
+	for _, name := range sortedKeys(g.enums) {
+		e := g.enums[name]
+		fmt.Fprintf(buf, "// %s is accepted by %s.\n", e.name, strings.Join(e.uses, ", "))
+		fmt.Fprintf(buf, "type %s string\n\nconst (\n", e.name)
+		for _, v := range e.values {
+			fmt.Fprintf(buf, "\t%s%s %s = %q\n", e.name, goName(v), e.name, v)
+		}
+		buf.WriteString(")\n\n")
+		fmt.Fprintf(buf, "// %sValues lists the values of %s.\n", e.name, e.name)
+		fmt.Fprintf(buf, "func %sValues() []string {\n\treturn []string{", e.name)
+		for i, v := range e.values {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			fmt.Fprintf(buf, "%q", v)
+		}
+		buf.WriteString("}\n}\n\n")
+	}
+}
+
+func (g *generator) writeOperation(buf *bytes.Buffer, op *operation) {
+	params := []string{"ctx context.Context"}
+	for _, s := range op.segments {
+		if s.param == "" {
+			continue
+		}
+		typ := "string"
+		if s.numeric {
+			typ = "int"
+		}
+		params = append(params, s.goName+" "+typ)
+	}
+	if len(op.query) > 0 {
+		params = append(params, "query *"+op.name+"Query")
+	}
+	bodyArg := "nil"
+	if op.body != nil {
+		params = append(params, "body *"+op.name+"Body")
+		bodyArg = "bodyOf(body)"
+	}
+
+	fmt.Fprintf(buf, "// %s calls %s %s", op.name, strings.ToUpper(op.method), op.path)
+	if desc := oneLine(op.op.Description); desc != "" {
+		fmt.Fprintf(buf, ": %s", desc)
+	}
+	buf.WriteString("\n")
+	fmt.Fprintf(buf, "func (a *API) %s(%s) (json.RawMessage, error) {\n", op.name, strings.Join(params, ", "))
+
+	var parts []string
+	literal := ""
+	for _, s := range op.segments {
+		if s.param == "" {
+			literal += "/" + s.literal
+			continue
+		}
+		literal += "/"
+		parts = append(parts, fmt.Sprintf("%q", literal))
+		literal = ""
+		if s.numeric {
+			parts = append(parts, "strconv.Itoa("+s.goName+")")
+		} else {
+			parts = append(parts, "url.PathEscape("+s.goName+")")
+		}
+	}
+	if literal != "" || len(parts) == 0 {
+		if literal == "" {
+			literal = "/"
+		}
+		parts = append(parts, fmt.Sprintf("%q", literal))
+	}
+	fmt.Fprintf(buf, "\tpath := %s\n", strings.Join(parts, " + "))
+	if len(op.query) > 0 {
+		buf.WriteString("\tif query != nil {\n\t\tif q := query.encode(); q != \"\" {\n\t\t\tpath += \"?\" + q\n\t\t}\n\t}\n")
+	}
+	fmt.Fprintf(buf, "\treturn a.client.doRequest(ctx, %q, path, %s)\n}\n\n", strings.ToUpper(op.method), bodyArg)
+
+	if len(op.query) > 0 {
+		g.writeQuery(buf, op)
+	}
+	if op.body != nil {
+		g.pending = append(g.pending, pendingStruct{
+			name:   op.name + "Body",
+			doc:    fmt.Sprintf("is the request body of %s %s.", strings.ToUpper(op.method), op.path),
+			schema: op.body,
+			noun:   op.noun,
+		})
+		for len(g.pending) > 0 {
+			st := g.pending[0]
+			g.pending = g.pending[1:]
+			g.writeStruct(buf, st.name, st.doc, st.schema, st.noun)
+		}
+	}
+}
+
+func (g *generator) writeQuery(buf *bytes.Buffer, op *operation) {
+	name := op.name + "Query"
+	fmt.Fprintf(buf, "// %s holds the query parameters of %s %s. Empty fields are not sent.\n", name, strings.ToUpper(op.method), op.path)
+	fmt.Fprintf(buf, "type %s struct {\n", name)
+	for _, p := range op.query {
+		desc := p.Description
+		if desc == "" && p.Schema != nil {
+			desc = p.Schema.Description
+		}
+		if desc = oneLine(desc); desc != "" {
+			fmt.Fprintf(buf, "\t// %s\n", desc)
+		}
+		typ := "string"
+		if len(enumValues(p.Schema)) > 0 {
+			typ = op.noun + goName(p.Name)
+		}
+		fmt.Fprintf(buf, "\t%s %s\n", goName(p.Name), typ)
+	}
+	buf.WriteString("}\n\n")
+
+	fmt.Fprintf(buf, "func (q *%s) encode() string {\n\tv := url.Values{}\n", name)
+	for _, p := range op.query {
+		value := "q." + goName(p.Name)
+		if len(enumValues(p.Schema)) > 0 {
+			value = "string(" + value + ")"
+		}
+		fmt.Fprintf(buf, "\tif q.%s != \"\" {\n\t\tv.Set(%q, %s)\n\t}\n", goName(p.Name), p.Name, value)
+	}
+	buf.WriteString("\treturn v.Encode()\n}\n\n")
+}
+
+func (g *generator) writeStruct(buf *bytes.Buffer, name, doc string, s *Schema, noun string) {
+	if g.emitted == nil {
+		g.emitted = map[string]bool{}
+	}
+	if g.emitted[name] {
+		return
+	}
+	g.emitted[name] = true
+
+	s = flatten(s)
+	fmt.Fprintf(buf, "// %s %s\n", name, doc)
+	if len(s.Properties) == 0 {
+		fmt.Fprintf(buf, "type %s map[string]any\n\n", name)
+		return
+	}
+	fmt.Fprintf(buf, "type %s struct {\n", name)
+	base := strings.TrimSuffix(name, "Body")
+	for _, prop := range sortedKeys(s.Properties) {
+		required := contains(s.Required, prop)
+		typ := g.fieldType(base, noun, prop, s.Properties[prop], required)
+		tag := prop
+		if !required {
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(buf, "\t%s %s `json:%q`\n", goName(prop), typ, tag)
+	}
+	buf.WriteString("}\n\n")
+}
+
+// fieldType maps a property schema to a Go type. Optional and nullable
+// scalars become pointers so that zero values can be sent explicitly.
+func (g *generator) fieldType(base, noun, prop string, s *Schema, required bool) string {
+	s = flatten(s)
+	typ := g.valueType(base, noun, prop, s)
+	if strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map[") || typ == "any" {
+		return typ
+	}
+	if !required || s.Nullable {
+		return "*" + typ
+	}
+	return typ
+}
+
+func (g *generator) valueType(base, noun, prop string, s *Schema) string {
+	if len(enumValues(s)) > 0 {
+		return noun + goName(prop)
+	}
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		if s.Items == nil {
+			return "[]any"
+		}
+		return "[]" + g.valueType(base, noun, prop, flatten(s.Items))
+	case "object":
+		name := base + singular(goName(prop))
+		g.pending = append(g.pending, pendingStruct{name: name, doc: fmt.Sprintf("is the %s element of %s.", prop, base), schema: s, noun: noun})
+		return name
+	}
+	return "any"
+}
+
+// flatten folds allOf into one schema and anyOf into the union of its
+// branches, keeping only the properties every branch requires as required.
+// A `- nullable: true` branch makes the result nullable.
+func flatten(s *Schema) *Schema {
+	if s == nil {
+		return nil
+	}
+	if len(s.AllOf) > 0 {
+		return flatten(s.merged())
+	}
+	if len(s.AnyOf) == 0 {
+		return s
+	}
+
+	var branches []*Schema
+	nullable := s.Nullable
+	for _, b := range s.AnyOf {
+		if b.Nullable && b.Type == "" {
+			nullable = true
+			continue
+		}
+		branches = append(branches, flatten(b))
+	}
+	if len(branches) == 1 {
+		out := *branches[0]
+		out.Nullable = out.Nullable || nullable
+		return &out
+	}
+
+	out := &Schema{Nullable: nullable, Properties: map[string]*Schema{}}
+	for i, b := range branches {
+		if i == 0 {
+			out.Type = b.Type
+			out.Required = append([]string(nil), b.Required...)
+		} else if out.Type != b.Type {
+			out.Type = ""
+		}
+		for name, prop := range b.Properties {
+			if _, ok := out.Properties[name]; !ok {
+				out.Properties[name] = prop
+			}
+		}
+		var required []string
+		for _, r := range out.Required {
+			if contains(b.Required, r) {
+				required = append(required, r)
+			}
+		}
+		out.Required = required
+	}
+	return out
+}
+
+var initialisms = map[string]string{
+	"2fa": "2FA", "api": "API", "asn": "ASN", "cidr": "CIDR", "dns": "DNS", "hc": "HC", "http": "HTTP",
+	"icmp": "ICMP", "id": "ID", "ids": "IDs", "ip": "IP", "oidc": "OIDC", "olm": "OLM", "sso": "SSO",
+	"ssl": "SSL", "tcp": "TCP", "tls": "TLS", "udp": "UDP", "url": "URL", "wireguard": "WireGuard",
+}
+
+// goName converts an API identifier such as "siteResourceId", "site-resource"
+// or "ACCEPT" to an exported Go name.
+func goName(s string) string {
+	var b strings.Builder
+	for _, w := range words(s) {
+		lower := strings.ToLower(w)
+		if init, ok := initialisms[lower]; ok {
+			b.WriteString(init)
+			continue
+		}
+		b.WriteString(strings.ToUpper(lower[:1]) + lower[1:])
+	}
+	return b.String()
+}
+
+// paramName converts a path parameter to an unexported Go name.
+func paramName(s string) string {
+	ws := words(s)
+	name := strings.ToLower(ws[0]) + goName(strings.Join(ws[1:], "-"))
+	if token.IsKeyword(name) {
+		name += "Param"
+	}
+	return name
+}
+
+func words(s string) []string {
+	var out []string
+	var cur []rune
+	flush := func() {
+		if len(cur) > 0 {
+			out = append(out, string(cur))
+			cur = nil
+		}
+	}
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && i > 0 && unicode.IsLower(runes[i-1]):
+			flush()
+			cur = append(cur, r)
+		default:
+			cur = append(cur, r)
+		}
+	}
+	flush()
+	return out
+}
+
+func singular(s string) string {
+	if strings.HasSuffix(s, "ss") || !strings.HasSuffix(s, "s") || strings.HasSuffix(s, "IDs") {
+		return s
+	}
+	return strings.TrimSuffix(s, "s")
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
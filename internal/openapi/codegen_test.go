@@ -0,0 +1,43 @@
+package openapi
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedClientUpToDate fails when internal/client/api_gen.go was not
+// regenerated after the spec or the generator changed.
+func TestGeneratedClientUpToDate(t *testing.T) {
+	want, err := Generate(loadSpec(t), "client")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filepath.Join("..", "client", "api_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("internal/client/api_gen.go is stale; run make generate")
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := map[string]string{
+		"orgId":              "OrgID",
+		"siteResourceId":     "SiteResourceID",
+		"clientIds":          "ClientIDs",
+		"hcTlsServerName":    "HCTLSServerName",
+		"tcpPortRangeString": "TCPPortRangeString",
+		"site-resources":     "SiteResources",
+		"2fa":                "2FA",
+		"wireguard":          "WireGuard",
+		"dns-records":        "DNSRecords",
+	}
+	for in, want := range tests {
+		if got := goName(in); got != want {
+			t.Errorf("goName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Package openapi loads the OpenAPI document of the Pangolin Integration API,
+// checks requests against it and generates the typed client from it. It understands the subset of OpenAPI 3.0
+// the document uses: path, query and JSON body parameters described by
+// schemas with types, enums, bounds, patterns and anyOf/allOf/oneOf.
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultPath is the location of the document relative to the repository root.
+const DefaultPath = "tests/env/config/openapi.yaml"
+
+// Spec is a parsed OpenAPI document.
+type Spec struct {
+	Servers []struct {
+		URL string `yaml:"url"`
+	} `yaml:"servers"`
+	Paths map[string]map[string]*Operation `yaml:"paths"`
+}
+
+// Operation is a single method on a path.
+type Operation struct {
+	Description string       `yaml:"description"`
+	Tags        []string     `yaml:"tags"`
+	Parameters  []Parameter  `yaml:"parameters"`
+	RequestBody *RequestBody `yaml:"requestBody"`
+}
+
+// Parameter is a path or query parameter.
+type Parameter struct {
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Description string  `yaml:"description"`
+	Required    bool    `yaml:"required"`
+	Schema      *Schema `yaml:"schema"`
+}
+
+// RequestBody describes the body of an operation by content type.
+type RequestBody struct {
+	Required bool `yaml:"required"`
+	Content  map[string]struct {
+		Schema *Schema `yaml:"schema"`
+	} `yaml:"content"`
+}
+
+// JSONSchema returns the schema of the application/json body, if any.
+func (b *RequestBody) JSONSchema() *Schema {
+	if b == nil {
+		return nil
+	}
+	return b.Content["application/json"].Schema
+}
+
+// Load parses the OpenAPI document at path.
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var spec Spec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &spec, nil
+}
+
+// BasePath is the path prefix of the first server, e.g. /v1.
+func (s *Spec) BasePath() string {
+	if len(s.Servers) == 0 {
+		return ""
+	}
+	return strings.TrimSuffix(s.Servers[0].URL, "/")
+}
+
+// Find returns the operation serving method and path, which is relative to
+// the base path, along with its path template and path parameters. When
+// several templates match, the one with the most literal segments wins.
+func (s *Spec) Find(method string, path string) (*Operation, string, map[string]string, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	best, bestLiterals := "", -1
+	var bestParams map[string]string
+	for template := range s.Paths {
+		params, literals, ok := matchTemplate(template, segments)
+		if ok && (literals > bestLiterals || (literals == bestLiterals && template < best)) {
+			best, bestLiterals, bestParams = template, literals, params
+		}
+	}
+	if best == "" {
+		return nil, "", nil, fmt.Errorf("%s %s: path is not in the spec", method, path)
+	}
+	op, ok := s.Paths[best][strings.ToLower(method)]
+	if !ok {
+		return nil, best, bestParams, fmt.Errorf("%s %s: %s does not allow %s", method, path, best, method)
+	}
+	return op, best, bestParams, nil
+}
+
+func matchTemplate(template string, segments []string) (map[string]string, int, bool) {
+	parts := strings.Split(strings.Trim(template, "/"), "/")
+	if len(parts) != len(segments) {
+		return nil, 0, false
+	}
+	params := map[string]string{}
+	literals := 0
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if segments[i] == "" {
+				return nil, 0, false
+			}
+			params[part[1:len(part)-1]] = segments[i]
+			continue
+		}
+		if part != segments[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+	return params, literals, true
+}
+
+// ValidateRequest checks a request against the spec. The path of u includes the
+// base path; body is the raw request body, empty when there is none.
+func (s *Spec) ValidateRequest(method string, u *url.URL, body []byte) error {
+	path, ok := strings.CutPrefix(u.Path, s.BasePath())
+	if !ok {
+		return fmt.Errorf("%s %s: path does not start with the base path %s", method, u.Path, s.BasePath())
+	}
+	op, template, pathParams, err := s.Find(method, path)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s %s: %s", method, template, fmt.Sprintf(format, args...)))
+	}
+
+	query := u.Query()
+	declared := map[string]bool{}
+	for _, p := range op.Parameters {
+		switch p.In {
+		case "path":
+			// Some operations declare path parameters their template lacks.
+			value, ok := pathParams[p.Name]
+			if !ok {
+				continue
+			}
+			if err := p.Schema.validateParam(value); err != nil {
+				fail("path parameter %s: %v", p.Name, err)
+			}
+		case "query":
+			declared[p.Name] = true
+			values, present := query[p.Name]
+			if !present {
+				if p.Required {
+					fail("missing required query parameter %s", p.Name)
+				}
+				continue
+			}
+			for _, v := range values {
+				if err := p.Schema.validateParam(v); err != nil {
+					fail("query parameter %s: %v", p.Name, err)
+				}
+			}
+		}
+	}
+	for _, name := range sortedKeys(query) {
+		if !declared[name] {
+			fail("undeclared query parameter %s", name)
+		}
+	}
+
+	schema := op.RequestBody.JSONSchema()
+	switch {
+	case len(bytes.TrimSpace(body)) == 0:
+		if schema != nil && (op.RequestBody.Required || len(schema.Required) > 0) {
+			fail("missing request body")
+		}
+	case schema == nil:
+		fail("sends a body but the operation takes none")
+	default:
+		dec := json.NewDecoder(bytes.NewReader(body))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			fail("body is not JSON: %v", err)
+			break
+		}
+		for _, err := range schema.Validate("body", v) {
+			fail("%v", err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
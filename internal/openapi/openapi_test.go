@@ -0,0 +1,113 @@
+package openapi
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func loadSpec(t *testing.T) *Spec {
+	t.Helper()
+	spec, err := Load(filepath.Join("..", "..", DefaultPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return spec
+}
+
+func TestFind(t *testing.T) {
+	spec := loadSpec(t)
+
+	tests := []struct {
+		method, path string
+		template     string
+		params       map[string]string
+	}{
+		{"GET", "/org/acme/roles", "/org/{orgId}/roles", map[string]string{"orgId": "acme"}},
+		{"PUT", "/org/acme/role", "/org/{orgId}/role", map[string]string{"orgId": "acme"}},
+		{"GET", "/role/3", "/role/{roleId}", map[string]string{"roleId": "3"}},
+		{"POST", "/resource/7/roles/add", "/resource/{resourceId}/roles/add", map[string]string{"resourceId": "7"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			_, template, params, err := spec.Find(tt.method, tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if template != tt.template {
+				t.Errorf("template = %s, want %s", template, tt.template)
+			}
+			for k, v := range tt.params {
+				if params[k] != v {
+					t.Errorf("param %s = %q, want %q", k, params[k], v)
+				}
+			}
+		})
+	}
+
+	if _, _, _, err := spec.Find("GET", "/no/such/path"); err == nil {
+		t.Error("expected an error for an unknown path")
+	}
+	if _, _, _, err := spec.Find("PATCH", "/role/3"); err == nil {
+		t.Error("expected an error for an unknown method")
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	spec := loadSpec(t)
+
+	tests := []struct {
+		name    string
+		method  string
+		url     string
+		body    string
+		wantErr string
+	}{
+		{name: "valid", method: "PUT", url: "/v1/org/acme/role", body: `{"name":"Ops","description":"Operators"}`},
+		{name: "missing base path", method: "GET", url: "/org/acme/roles", wantErr: "base path"},
+		{name: "missing required property", method: "PUT", url: "/v1/org/acme/role", body: `{"description":"x"}`, wantErr: "missing required property name"},
+		{name: "unknown property", method: "PUT", url: "/v1/org/acme/role", body: `{"name":"Ops","colour":"red"}`, wantErr: "unknown property colour"},
+		{name: "wrong type", method: "PUT", url: "/v1/org/acme/role", body: `{"name":42}`, wantErr: "expected string"},
+		{name: "missing body", method: "PUT", url: "/v1/org/acme/role", wantErr: "missing request body"},
+		{name: "unexpected body", method: "GET", url: "/v1/role/3", body: `{}`, wantErr: "takes none"},
+		{name: "non-numeric path parameter", method: "GET", url: "/v1/site/main", wantErr: "path parameter siteId"},
+		{name: "undeclared query parameter", method: "GET", url: "/v1/org/acme/roles?page=2", wantErr: "undeclared query parameter page"},
+		{name: "declared query parameters", method: "GET", url: "/v1/org/acme/roles?limit=1000&offset=0"},
+		{name: "allOf allows properties of any branch", method: "POST", url: "/v1/resource/7", body: `{"name":"App","subdomain":"app","proxyPort":8080}`},
+		{name: "allOf rejects unknown properties", method: "POST", url: "/v1/resource/7", body: `{"protocol":"tcp"}`, wantErr: "unknown property protocol"},
+		{name: "enum", method: "PUT", url: "/v1/org/acme/site", body: `{"name":"s","type":"carrier-pigeon"}`, wantErr: "is not one of"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = spec.ValidateRequest(tt.method, u, []byte(tt.body))
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("expected an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("error %q does not contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemaAnyOfNullable(t *testing.T) {
+	min := 1
+	s := &Schema{AnyOf: []*Schema{{Type: "string", MinLength: &min}, {Nullable: true}}}
+
+	if errs := s.Validate("v", nil); len(errs) != 0 {
+		t.Errorf("null: %v", errs)
+	}
+	if errs := s.Validate("v", "x"); len(errs) != 0 {
+		t.Errorf("string: %v", errs)
+	}
+	if errs := s.Validate("v", ""); len(errs) == 0 {
+		t.Error("expected the empty string to fail minLength")
+	}
+}
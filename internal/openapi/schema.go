@@ -0,0 +1,314 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Schema is the subset of the OpenAPI 3.0 schema object the document uses.
+type Schema struct {
+	Type                 string             `yaml:"type"`
+	Description          string             `yaml:"description"`
+	Format               string             `yaml:"format"`
+	Nullable             bool               `yaml:"nullable"`
+	Enum                 []any              `yaml:"enum"`
+	Default              any                `yaml:"default"`
+	Properties           map[string]*Schema `yaml:"properties"`
+	Required             []string           `yaml:"required"`
+	AdditionalProperties *bool              `yaml:"additionalProperties"`
+	Items                *Schema            `yaml:"items"`
+	MinLength            *int               `yaml:"minLength"`
+	MaxLength            *int               `yaml:"maxLength"`
+	Minimum              *float64           `yaml:"minimum"`
+	Maximum              *float64           `yaml:"maximum"`
+	ExclusiveMinimum     bool               `yaml:"exclusiveMinimum"`
+	ExclusiveMaximum     bool               `yaml:"exclusiveMaximum"`
+	Pattern              string             `yaml:"pattern"`
+	AnyOf                []*Schema          `yaml:"anyOf"`
+	AllOf                []*Schema          `yaml:"allOf"`
+	OneOf                []*Schema          `yaml:"oneOf"`
+}
+
+// Validate checks a JSON value decoded with json.Decoder.UseNumber against
+// the schema and returns one error per violation, prefixed with where.
+func (s *Schema) Validate(where string, v any) []error {
+	if s == nil {
+		return nil
+	}
+	if v == nil {
+		if s.Nullable || s.isOnlyNullable() {
+			return nil
+		}
+		if s.Type == "" && len(s.AnyOf)+len(s.OneOf)+len(s.AllOf) == 0 {
+			return nil
+		}
+	}
+
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", where, fmt.Sprintf(format, args...)))
+	}
+
+	if len(s.AllOf) > 0 {
+		return append(errs, s.merged().Validate(where, v)...)
+	}
+	if len(s.AnyOf) > 0 && !s.matchesAny(s.AnyOf, where, v, &errs) {
+		return errs
+	}
+	if len(s.OneOf) > 0 && !s.matchesAny(s.OneOf, where, v, &errs) {
+		return errs
+	}
+
+	if s.Type != "" && !hasType(s.Type, v) {
+		fail("expected %s, got %s", s.Type, describe(v))
+		return errs
+	}
+
+	if len(s.Enum) > 0 && !inEnum(s.Enum, v) {
+		fail("%s is not one of %v", describe(v), s.Enum)
+	}
+
+	switch v := v.(type) {
+	case string:
+		n := utf8.RuneCountInString(v)
+		if s.MinLength != nil && n < *s.MinLength {
+			fail("shorter than %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			fail("longer than %d characters", *s.MaxLength)
+		}
+		if re := compilePattern(s.Pattern); re != nil && !re.MatchString(v) {
+			fail("%q does not match %s", v, s.Pattern)
+		}
+		if err := checkFormat(s.Format, v); err != nil {
+			fail("%v", err)
+		}
+	case json.Number:
+		f, _ := v.Float64()
+		errs = append(errs, s.checkBounds(where, f)...)
+	case []any:
+		for i, item := range v {
+			errs = append(errs, s.Items.Validate(fmt.Sprintf("%s[%d]", where, i), item)...)
+		}
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				fail("missing required property %s", name)
+			}
+		}
+		for _, name := range sortedKeys(v) {
+			prop, ok := s.Properties[name]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					fail("unknown property %s", name)
+				}
+				continue
+			}
+			errs = append(errs, prop.Validate(where+"."+name, v[name])...)
+		}
+	}
+	return errs
+}
+
+// isOnlyNullable reports whether an anyOf branch is the `- nullable: true`
+// idiom the document uses to allow null alongside another schema.
+func (s *Schema) isOnlyNullable() bool {
+	for _, sub := range s.AnyOf {
+		if sub.Nullable && sub.Type == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// merged folds allOf into a single schema. The document's generator emits
+// intersections of closed objects as allOf, where each branch lists only its
+// own properties, so a property is allowed when any branch declares it.
+func (s *Schema) merged() *Schema {
+	parts := s.AllOf
+	if len(s.Properties) > 0 {
+		parts = append([]*Schema{{Properties: s.Properties, AdditionalProperties: s.AdditionalProperties}}, parts...)
+	}
+
+	out := *s
+	out.AllOf = nil
+	out.Properties = map[string]*Schema{}
+	closed := len(parts) > 0
+	for _, part := range parts {
+		if len(part.AllOf) > 0 {
+			part = part.merged()
+		}
+		if out.Type == "" {
+			out.Type = part.Type
+		}
+		for name, prop := range part.Properties {
+			if _, ok := out.Properties[name]; !ok {
+				out.Properties[name] = prop
+			}
+		}
+		out.Required = append(out.Required, part.Required...)
+		if part.AdditionalProperties == nil || *part.AdditionalProperties {
+			closed = false
+		}
+	}
+	out.AdditionalProperties = nil
+	if closed {
+		out.AdditionalProperties = new(bool)
+	}
+	return &out
+}
+
+func (s *Schema) matchesAny(subs []*Schema, where string, v any, errs *[]error) bool {
+	var reasons []string
+	for _, sub := range subs {
+		if v != nil && sub.Nullable && sub.Type == "" {
+			continue
+		}
+		subErrs := sub.Validate(where, v)
+		if len(subErrs) == 0 {
+			return true
+		}
+		for _, err := range subErrs {
+			reasons = append(reasons, err.Error())
+		}
+	}
+	*errs = append(*errs, fmt.Errorf("%s: matches none of the alternatives (%s)", where, strings.Join(reasons, "; ")))
+	return false
+}
+
+func (s *Schema) checkBounds(where string, f float64) []error {
+	var errs []error
+	if s.Type == "integer" && f != math.Trunc(f) {
+		errs = append(errs, fmt.Errorf("%s: %v is not an integer", where, f))
+	}
+	if s.Minimum != nil && (f < *s.Minimum || (s.ExclusiveMinimum && f == *s.Minimum)) {
+		errs = append(errs, fmt.Errorf("%s: %v is below the minimum %v", where, f, *s.Minimum))
+	}
+	if s.Maximum != nil && (f > *s.Maximum || (s.ExclusiveMaximum && f == *s.Maximum)) {
+		errs = append(errs, fmt.Errorf("%s: %v is above the maximum %v", where, f, *s.Maximum))
+	}
+	return errs
+}
+
+// validateParam checks a path or query parameter, which arrives as text.
+func (s *Schema) validateParam(raw string) error {
+	if s == nil {
+		return nil
+	}
+	var v any = raw
+	switch s.Type {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(raw, 64); err != nil {
+			return fmt.Errorf("%q is not a number", raw)
+		}
+		v = json.Number(raw)
+	case "boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", raw)
+		}
+		v = b
+	}
+	if errs := s.Validate("value", v); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func hasType(typ string, v any) bool {
+	switch typ {
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "number":
+		_, ok := v.(json.Number)
+		return ok
+	case "integer":
+		n, ok := v.(json.Number)
+		if !ok {
+			return false
+		}
+		_, err := n.Int64()
+		return err == nil
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "array":
+		_, ok := v.([]any)
+		return ok
+	case "object":
+		_, ok := v.(map[string]any)
+		return ok
+	}
+	return true
+}
+
+func inEnum(enum []any, v any) bool {
+	for _, e := range enum {
+		if fmt.Sprint(e) == fmt.Sprint(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func describe(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(v)
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+var patterns sync.Map
+
+// compilePattern returns nil for patterns RE2 cannot express, such as the
+// lookaheads in the subdomain pattern; those are left to the server.
+func compilePattern(pattern string) *regexp.Regexp {
+	if pattern == "" {
+		return nil
+	}
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+	re, _ := regexp.Compile(pattern)
+	patterns.Store(pattern, re)
+	return re
+}
+
+func checkFormat(format, v string) error {
+	switch format {
+	case "email":
+		if _, err := mail.ParseAddress(v); err != nil {
+			return fmt.Errorf("%q is not an email address", v)
+		}
+	case "uri":
+		if u, err := url.Parse(v); err != nil || u.Scheme == "" {
+			return fmt.Errorf("%q is not an absolute URI", v)
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			return fmt.Errorf("%q is not an RFC 3339 date-time", v)
+		}
+	}
+	return nil
+}
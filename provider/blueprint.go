@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// errUnknownValue is returned when a value cannot be converted yet because
+// part of it is only known after apply.
+var errUnknownValue = errors.New("value is not known yet")
+
+// canonicalBlueprintFromText parses a YAML or JSON blueprint document and
+// returns it as canonical JSON with sorted keys.
+func canonicalBlueprintFromText(text string) ([]byte, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal([]byte(text), &doc); err != nil {
+		return nil, fmt.Errorf("blueprint is not valid YAML or JSON: %w", err)
+	}
+
+	doc, err := normalizeYAML(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := doc.(map[string]interface{}); !ok {
+		return nil, errors.New("blueprint must be an object at the top level")
+	}
+
+	return json.Marshal(doc)
+}
+
+// canonicalBlueprintFromValue converts an HCL object into canonical JSON with
+// sorted keys.
+func canonicalBlueprintFromValue(v attr.Value) ([]byte, error) {
+	doc, err := attrValueToInterface(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := doc.(map[string]interface{}); !ok {
+		return nil, errors.New("blueprint must be an object at the top level")
+	}
+
+	return json.Marshal(doc)
+}
+
+// blueprintHash returns the hex encoded SHA-256 of a canonical blueprint.
+func blueprintHash(canonical []byte) string {
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeYAML converts the maps produced by the YAML decoder into
+// map[string]interface{} so the result can be encoded as JSON.
+func normalizeYAML(v interface{}) (interface{}, error) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			n, err := normalizeYAML(item)
+			if err != nil {
+				return nil, err
+			}
+			val[k] = n
+		}
+		return val, nil
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			n, err := normalizeYAML(item)
+			if err != nil {
+				return nil, err
+			}
+			out[fmt.Sprint(k)] = n
+		}
+		return out, nil
+	case []interface{}:
+		for i, item := range val {
+			n, err := normalizeYAML(item)
+			if err != nil {
+				return nil, err
+			}
+			val[i] = n
+		}
+		return val, nil
+	default:
+		return val, nil
+	}
+}
+
+// attrValueToInterface converts a Terraform value into plain Go values that
+// encoding/json understands.
+func attrValueToInterface(v attr.Value) (interface{}, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() {
+		return nil, errUnknownValue
+	}
+
+	switch val := v.(type) {
+	case types.Dynamic:
+		return attrValueToInterface(val.UnderlyingValue())
+	case types.String:
+		return val.ValueString(), nil
+	case types.Bool:
+		return val.ValueBool(), nil
+	case types.Int64:
+		return val.ValueInt64(), nil
+	case types.Float64:
+		return val.ValueFloat64(), nil
+	case types.Number:
+		return numberToInterface(val.ValueBigFloat()), nil
+	case types.List:
+		return elementsToInterface(val.Elements())
+	case types.Set:
+		return elementsToInterface(val.Elements())
+	case types.Tuple:
+		return elementsToInterface(val.Elements())
+	case types.Map:
+		return attributesToInterface(val.Elements())
+	case types.Object:
+		return attributesToInterface(val.Attributes())
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+func numberToInterface(f *big.Float) interface{} {
+	if f.IsInt() {
+		if i, acc := f.Int64(); acc == big.Exact {
+			return i
+		}
+	}
+	return json.Number(f.Text('g', -1))
+}
+
+func elementsToInterface(elems []attr.Value) (interface{}, error) {
+	out := make([]interface{}, len(elems))
+	for i, e := range elems {
+		v, err := attrValueToInterface(e)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+func attributesToInterface(attrs map[string]attr.Value) (interface{}, error) {
+	out := make(map[string]interface{}, len(attrs))
+	for k, a := range attrs {
+		v, err := attrValueToInterface(a)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = v
+	}
+	return out, nil
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCanonicalBlueprint_YAMLAndJSONMatch(t *testing.T) {
+	yamlDoc := `
+proxy-resources:
+  web:
+    name: Web
+    protocol: http
+    full-domain: web.example.com
+    targets:
+      - site: main
+        hostname: web
+        port: 8080
+`
+	jsonDoc := `{"proxy-resources":{"web":{"targets":[{"port":8080,"hostname":"web","site":"main"}],"full-domain":"web.example.com","protocol":"http","name":"Web"}}}`
+
+	fromYAML, err := canonicalBlueprintFromText(yamlDoc)
+	if err != nil {
+		t.Fatalf("YAML: %v", err)
+	}
+	fromJSON, err := canonicalBlueprintFromText(jsonDoc)
+	if err != nil {
+		t.Fatalf("JSON: %v", err)
+	}
+
+	if string(fromYAML) != string(fromJSON) {
+		t.Fatalf("canonical forms differ:\n%s\n%s", fromYAML, fromJSON)
+	}
+	if blueprintHash(fromYAML) != blueprintHash(fromJSON) {
+		t.Fatal("hashes differ")
+	}
+}
+
+func TestCanonicalBlueprint_RejectsNonObject(t *testing.T) {
+	if _, err := canonicalBlueprintFromText("- a\n- b\n"); err == nil {
+		t.Fatal("expected an error for a top-level list")
+	}
+	if _, err := canonicalBlueprintFromText("a: [b"); err == nil {
+		t.Fatal("expected an error for invalid YAML")
+	}
+}
+
+func TestCanonicalBlueprint_FromValue(t *testing.T) {
+	target := types.ObjectValueMust(
+		map[string]attr.Type{"site": types.StringType, "port": types.NumberType},
+		map[string]attr.Value{"site": types.StringValue("main"), "port": types.NumberValue(bigFloat(8080))},
+	)
+	value := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"targets": types.TupleType{ElemTypes: []attr.Type{target.Type(nil)}}},
+		map[string]attr.Value{"targets": types.TupleValueMust([]attr.Type{target.Type(nil)}, []attr.Value{target})},
+	))
+
+	got, err := canonicalBlueprintFromValue(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"targets":[{"port":8080,"site":"main"}]}`
+	if string(got) != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
+
+func TestCanonicalBlueprint_UnknownValue(t *testing.T) {
+	value := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"name": types.StringType},
+		map[string]attr.Value{"name": types.StringUnknown()},
+	))
+
+	if _, err := canonicalBlueprintFromValue(value); !errors.Is(err, errUnknownValue) {
+		t.Fatalf("expected errUnknownValue, got %v", err)
+	}
+}
+
+func bigFloat(f float64) *big.Float {
+	return big.NewFloat(f)
+}
+
+func TestBlueprintApply_Failed(t *testing.T) {
+	for _, id := range []int{0, 9} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"success":true,"data":{"blueprintId":%d,"name":"Sunny Blueprint","succeeded":false,"message":"invalid target"}}`, id)
+		}))
+		defer srv.Close()
+
+		r := &blueprintResource{client: client.NewClient(srv.URL, "token")}
+		data := blueprintResourceModel{OrgID: types.StringValue("acme"), Content: types.StringValue("proxy-resources: {}"), Config: types.DynamicNull()}
+		diags := r.apply(t.Context(), &data)
+		if !diags.HasError() {
+			t.Errorf("blueprint %d: expected an unsuccessful apply to fail", id)
+		}
+	}
+}
+
+func TestBlueprintApply_Succeeded(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":true,"data":{"blueprintId":9,"name":"Sunny Blueprint","succeeded":true,"message":"applied"}}`)
+	}))
+	defer srv.Close()
+
+	r := &blueprintResource{client: client.NewClient(srv.URL, "token")}
+	data := blueprintResourceModel{OrgID: types.StringValue("acme"), Content: types.StringValue("proxy-resources: {}"), Config: types.DynamicNull()}
+	if diags := r.apply(t.Context(), &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !data.Succeeded.ValueBool() || data.ID.ValueInt64() != 9 || data.Message.ValueString() != "applied" {
+		t.Errorf("unexpected state %+v", data)
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// skipConnectionCheck reports whether Configure must not contact the API:
+// when asked to, or while connection settings are still unknown.
+func skipConnectionCheck(data pangolinProviderModel) bool {
+	for _, v := range []types.String{data.BaseURL, data.Token, data.TokenFile, data.Profile, data.OrgID} {
+		if v.IsUnknown() {
+			return true
+		}
+	}
+	if !data.SkipConnCheck.IsNull() {
+		return data.SkipConnCheck.ValueBool()
+	}
+	skip, _ := strconv.ParseBool(os.Getenv("PANGOLIN_SKIP_CONNECTION_CHECK"))
+	return skip
+}
+
+// checkConnection calls the health endpoint, then verifies the token with an
+// authenticated request. When the token is an organization API key and a
+// default organization is set, that request lists the key's actions, which
+// are compared with required.
+func checkConnection(ctx context.Context, c *client.Client, required types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := c.Ping(ctx)
+	switch {
+	case errors.Is(err, client.ErrNotIntegrationAPI):
+		diags.AddAttributeError(path.Root("base_url"), "Not a Pangolin Integration API",
+			fmt.Sprintf("%s does not answer like the Pangolin Integration API: %s\n\n"+
+				"Check that base_url points to the integration port (3003 by default, not the dashboard's 3000) and ends in /v1.", c.BaseURL, err))
+	case errors.Is(err, client.ErrInvalidToken):
+		diags.AddAttributeError(path.Root("token"), "Invalid API Token",
+			fmt.Sprintf("%s rejected the API token: %s", c.BaseURL, err))
+	case err != nil:
+		diags.AddError("Pangolin API Unreachable",
+			fmt.Sprintf("Could not reach %s: %s\n\nSet skip_connection_check to configure the provider without contacting the API.", c.BaseURL, err))
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	var want []string
+	diags.Append(required.ElementsAs(ctx, &want, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	notChecked := func(reason string) {
+		if len(want) > 0 {
+			diags.AddAttributeWarning(path.Root("required_actions"), "Token Actions Not Checked", reason)
+		}
+	}
+
+	apiKeyID, isKey := client.APIKeyID(c.Token)
+	if c.OrgID == "" || !isKey {
+		err := c.CheckToken(ctx)
+		switch {
+		case errors.Is(err, client.ErrInvalidToken):
+			diags.AddAttributeError(path.Root("token"), "Invalid API Token",
+				fmt.Sprintf("%s rejected the API token: %s", c.BaseURL, err))
+			return diags
+		case err != nil:
+			diags.AddError("Pangolin API Token Not Verified",
+				fmt.Sprintf("Could not verify the API token with %s: %s\n\nSet skip_connection_check to configure the provider without contacting the API.", c.BaseURL, err))
+			return diags
+		}
+		if c.OrgID == "" {
+			notChecked("The actions of the token are listed in the default organization, and org_id is not set.")
+		} else {
+			notChecked("The token is not an organization API key of the form <apiKeyId>.<secret>.")
+		}
+		return diags
+	}
+	granted, err := c.GetAPIKeyActions(ctx, c.OrgID, apiKeyID)
+	switch {
+	case client.IsUnauthorized(err):
+		diags.AddAttributeError(path.Root("token"), "Invalid API Token",
+			fmt.Sprintf("%s rejected the API token: %s", c.BaseURL, err))
+		return diags
+	case err != nil:
+		tflog.Debug(ctx, "Could not list the actions of the token", map[string]interface{}{"error": err.Error()})
+		notChecked(fmt.Sprintf("Could not list the actions of API key %s in organization %s: %s", apiKeyID, c.OrgID, err))
+		return diags
+	}
+
+	var missing []string
+	for _, action := range want {
+		if !slices.Contains(granted, action) {
+			missing = append(missing, action)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		diags.AddAttributeError(path.Root("required_actions"), "API Token Lacks Actions",
+			fmt.Sprintf("The API key lacks the actions %s in organization %s. Grant them to the key in the Pangolin dashboard or with the pangolin_api_key resource.", strings.Join(missing, ", "), c.OrgID))
+	}
+	return diags
+}
@@ -0,0 +1,550 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/blueprint"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &blueprintDocumentDataSource{}
+
+func NewBlueprintDocumentDataSource() datasource.DataSource {
+	return &blueprintDocumentDataSource{}
+}
+
+type blueprintDocumentDataSource struct{}
+
+type blueprintDocumentDataSourceModel struct {
+	ProxyResources   []blueprintProxyResourceModel   `tfsdk:"proxy_resource"`
+	PrivateResources []blueprintPrivateResourceModel `tfsdk:"private_resource"`
+	JSON             types.String                    `tfsdk:"json"`
+	YAML             types.String                    `tfsdk:"yaml"`
+	Base64           types.String                    `tfsdk:"base64"`
+	Labels           types.Map                       `tfsdk:"labels"`
+}
+
+type blueprintProxyResourceModel struct {
+	Key           types.String           `tfsdk:"key"`
+	Name          types.String           `tfsdk:"name"`
+	Protocol      types.String           `tfsdk:"protocol"`
+	FullDomain    types.String           `tfsdk:"full_domain"`
+	ProxyPort     types.Int64            `tfsdk:"proxy_port"`
+	SSL           types.Bool             `tfsdk:"ssl"`
+	Enabled       types.Bool             `tfsdk:"enabled"`
+	HostHeader    types.String           `tfsdk:"host_header"`
+	TLSServerName types.String           `tfsdk:"tls_server_name"`
+	Headers       []blueprintHeaderModel `tfsdk:"header"`
+	Auth          *blueprintAuthModel    `tfsdk:"auth"`
+	Rules         []blueprintRuleModel   `tfsdk:"rule"`
+	Targets       []blueprintTargetModel `tfsdk:"target"`
+}
+
+type blueprintHeaderModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+type blueprintAuthModel struct {
+	Pincode           types.String `tfsdk:"pincode"`
+	Password          types.String `tfsdk:"password"`
+	BasicAuthUser     types.String `tfsdk:"basic_auth_user"`
+	BasicAuthPassword types.String `tfsdk:"basic_auth_password"`
+	SSOEnabled        types.Bool   `tfsdk:"sso_enabled"`
+	SSORoles          []string     `tfsdk:"sso_roles"`
+	SSOUsers          []string     `tfsdk:"sso_users"`
+	WhitelistUsers    []string     `tfsdk:"whitelist_users"`
+}
+
+type blueprintRuleModel struct {
+	Action types.String `tfsdk:"action"`
+	Match  types.String `tfsdk:"match"`
+	Value  types.String `tfsdk:"value"`
+}
+
+type blueprintTargetModel struct {
+	Site         types.String `tfsdk:"site"`
+	Hostname     types.String `tfsdk:"hostname"`
+	Port         types.Int64  `tfsdk:"port"`
+	Method       types.String `tfsdk:"method"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Path         types.String `tfsdk:"path"`
+	PathMatch    types.String `tfsdk:"path_match"`
+	RewritePath  types.String `tfsdk:"rewrite_path"`
+	RewriteMatch types.String `tfsdk:"rewrite_match"`
+	Priority     types.Int64  `tfsdk:"priority"`
+}
+
+type blueprintPrivateResourceModel struct {
+	Key         types.String `tfsdk:"key"`
+	Name        types.String `tfsdk:"name"`
+	Mode        types.String `tfsdk:"mode"`
+	Site        types.String `tfsdk:"site"`
+	Destination types.String `tfsdk:"destination"`
+	Alias       types.String `tfsdk:"alias"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	TCPPorts    types.String `tfsdk:"tcp_ports"`
+	UDPPorts    types.String `tfsdk:"udp_ports"`
+	DisableICMP types.Bool   `tfsdk:"disable_icmp"`
+	Roles       []string     `tfsdk:"roles"`
+	Users       []string     `tfsdk:"users"`
+}
+
+func (d *blueprintDocumentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_document"
+}
+
+func (d *blueprintDocumentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Builds a Pangolin blueprint from typed blocks and renders it as JSON, YAML, base64 or docker labels. " +
+			"The document is validated locally, so mistakes surface at plan time instead of when the blueprint is applied. " +
+			"This data source does not call the API. The rendered outputs are sensitive since they carry any `auth` passwords and PIN codes; " +
+			"wrap them in `nonsensitive()` to show a document without secrets in plans.",
+		Attributes: map[string]schema.Attribute{
+			"json": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The blueprint as canonical JSON, suitable for the `content` attribute of `pangolin_blueprint`.",
+			},
+			"yaml": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The blueprint as YAML.",
+			},
+			"base64": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The JSON blueprint, base64 encoded as expected by the blueprint API.",
+			},
+			"labels": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The blueprint flattened into docker labels for newt, e.g. `pangolin.proxy-resources.web.targets[0].port`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"proxy_resource": schema.ListNestedBlock{
+				MarkdownDescription: "A public HTTP, TCP or UDP resource.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The unique key of the resource within the blueprint.",
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the resource.",
+						},
+						"protocol": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The protocol of the resource: `http`, `tcp` or `udp`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("http", "tcp", "udp"),
+							},
+						},
+						"full_domain": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The full domain of an `http` resource.",
+						},
+						"proxy_port": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "The public port of a `tcp` or `udp` resource.",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"ssl": schema.BoolAttribute{
+							Optional:            true,
+							MarkdownDescription: "Whether to serve the resource over HTTPS.",
+						},
+						"enabled": schema.BoolAttribute{
+							Optional:            true,
+							MarkdownDescription: "Whether the resource is enabled.",
+						},
+						"host_header": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The Host header sent to targets.",
+						},
+						"tls_server_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The TLS server name (SNI) used when connecting to targets.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"header": schema.ListNestedBlock{
+							MarkdownDescription: "A custom header added to proxied requests.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The header name.",
+									},
+									"value": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The header value.",
+									},
+								},
+							},
+						},
+						"auth": schema.SingleNestedBlock{
+							MarkdownDescription: "Authentication settings of an `http` resource.",
+							Attributes: map[string]schema.Attribute{
+								"pincode": schema.StringAttribute{
+									Optional:            true,
+									Sensitive:           true,
+									MarkdownDescription: "A 6 digit PIN code required to access the resource.",
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(regexp.MustCompile(`^\d{6}$`), "must be 6 digits"),
+									},
+								},
+								"password": schema.StringAttribute{
+									Optional:            true,
+									Sensitive:           true,
+									MarkdownDescription: "A password required to access the resource.",
+								},
+								"basic_auth_user": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "The user for HTTP basic authentication.",
+								},
+								"basic_auth_password": schema.StringAttribute{
+									Optional:            true,
+									Sensitive:           true,
+									MarkdownDescription: "The password for HTTP basic authentication.",
+								},
+								"sso_enabled": schema.BoolAttribute{
+									Optional:            true,
+									MarkdownDescription: "Whether platform SSO is required to access the resource.",
+								},
+								"sso_roles": schema.ListAttribute{
+									ElementType:         types.StringType,
+									Optional:            true,
+									MarkdownDescription: "The names of the roles allowed to access the resource.",
+								},
+								"sso_users": schema.ListAttribute{
+									ElementType:         types.StringType,
+									Optional:            true,
+									MarkdownDescription: "The emails of the users allowed to access the resource.",
+								},
+								"whitelist_users": schema.ListAttribute{
+									ElementType:         types.StringType,
+									Optional:            true,
+									MarkdownDescription: "The emails allowed to access the resource with a one-time password.",
+								},
+							},
+						},
+						"rule": schema.ListNestedBlock{
+							MarkdownDescription: "An access rule, evaluated in order.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"action": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The action: `allow`, `deny` or `pass`.",
+										Validators: []validator.String{
+											stringvalidator.OneOf("allow", "deny", "pass"),
+										},
+									},
+									"match": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "What to match on: `cidr`, `ip`, `path` or `country`.",
+										Validators: []validator.String{
+											stringvalidator.OneOf("cidr", "ip", "path", "country"),
+										},
+									},
+									"value": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The value to match.",
+									},
+								},
+							},
+						},
+						"target": schema.ListNestedBlock{
+							MarkdownDescription: "A backend the resource proxies to.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"site": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "The nice ID of the site the target is reached through. Defaults to the site applying the blueprint.",
+									},
+									"hostname": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The hostname or IP of the target.",
+									},
+									"port": schema.Int64Attribute{
+										Required:            true,
+										MarkdownDescription: "The port of the target.",
+										Validators: []validator.Int64{
+											int64validator.Between(1, 65535),
+										},
+									},
+									"method": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "The scheme used to reach an `http` target: `http`, `https` or `h2c`.",
+										Validators: []validator.String{
+											stringvalidator.OneOf("http", "https", "h2c"),
+										},
+									},
+									"enabled": schema.BoolAttribute{
+										Optional:            true,
+										MarkdownDescription: "Whether the target is enabled.",
+									},
+									"path": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "The request path routed to this target.",
+									},
+									"path_match": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "How `path` is matched: `exact`, `prefix` or `regex`.",
+										Validators: []validator.String{
+											stringvalidator.OneOf("exact", "prefix", "regex"),
+										},
+									},
+									"rewrite_path": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "The path the request is rewritten to.",
+									},
+									"rewrite_match": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "How the path is rewritten: `exact`, `prefix`, `regex` or `stripPrefix`.",
+										Validators: []validator.String{
+											stringvalidator.OneOf("exact", "prefix", "regex", "stripPrefix"),
+										},
+									},
+									"priority": schema.Int64Attribute{
+										Optional:            true,
+										MarkdownDescription: "The routing priority of the target.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"private_resource": schema.ListNestedBlock{
+				MarkdownDescription: "A private resource reachable by clients through a site.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The unique key of the resource within the blueprint.",
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the resource.",
+						},
+						"mode": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The mode of the resource: `host` or `cidr`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("host", "cidr"),
+							},
+						},
+						"site": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The nice ID of the site providing access.",
+						},
+						"destination": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The destination host or CIDR.",
+						},
+						"alias": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "A DNS alias clients can use to reach a `host` resource.",
+						},
+						"enabled": schema.BoolAttribute{
+							Optional:            true,
+							MarkdownDescription: "Whether the resource is enabled.",
+						},
+						"tcp_ports": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The allowed TCP ports, e.g. `22,80-443` or `*`.",
+						},
+						"udp_ports": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The allowed UDP ports, e.g. `53` or `*`.",
+						},
+						"disable_icmp": schema.BoolAttribute{
+							Optional:            true,
+							MarkdownDescription: "Whether to block ICMP to the destination.",
+						},
+						"roles": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "The names of the roles allowed to access the resource.",
+						},
+						"users": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "The emails of the users allowed to access the resource.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *blueprintDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data blueprintDocumentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bp, diags := expandBlueprintDocument(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := bp.Validate(); err != nil {
+		resp.Diagnostics.AddError("Invalid Blueprint", err.Error())
+		return
+	}
+
+	jsonDoc, err := bp.JSON()
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering blueprint", err.Error())
+		return
+	}
+	yamlDoc, err := bp.YAML()
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering blueprint", err.Error())
+		return
+	}
+	encoded, err := bp.Base64()
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering blueprint", err.Error())
+		return
+	}
+	labels, err := bp.Labels()
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering blueprint", err.Error())
+		return
+	}
+
+	data.JSON = types.StringValue(string(jsonDoc))
+	data.YAML = types.StringValue(string(yamlDoc))
+	data.Base64 = types.StringValue(encoded)
+	labelsMap, diags := types.MapValueFrom(ctx, types.StringType, labels)
+	resp.Diagnostics.Append(diags...)
+	data.Labels = labelsMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func expandBlueprintDocument(data blueprintDocumentDataSourceModel) (*blueprint.Blueprint, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	bp := &blueprint.Blueprint{}
+
+	if len(data.ProxyResources) > 0 {
+		bp.ProxyResources = make(map[string]blueprint.ProxyResource, len(data.ProxyResources))
+	}
+	for _, r := range data.ProxyResources {
+		key := r.Key.ValueString()
+		if _, ok := bp.ProxyResources[key]; ok {
+			diags.AddError("Duplicate Proxy Resource", fmt.Sprintf("The key %q is used by more than one proxy_resource block.", key))
+			continue
+		}
+
+		res := blueprint.ProxyResource{
+			Name:          r.Name.ValueString(),
+			Protocol:      r.Protocol.ValueString(),
+			FullDomain:    r.FullDomain.ValueString(),
+			ProxyPort:     int(r.ProxyPort.ValueInt64()),
+			SSL:           r.SSL.ValueBoolPointer(),
+			Enabled:       r.Enabled.ValueBoolPointer(),
+			HostHeader:    r.HostHeader.ValueString(),
+			TLSServerName: r.TLSServerName.ValueString(),
+		}
+
+		for _, h := range r.Headers {
+			res.Headers = append(res.Headers, blueprint.Header{
+				Name:  h.Name.ValueString(),
+				Value: h.Value.ValueString(),
+			})
+		}
+
+		if r.Auth != nil {
+			auth := &blueprint.Auth{
+				Pincode:        r.Auth.Pincode.ValueString(),
+				Password:       r.Auth.Password.ValueString(),
+				SSOEnabled:     r.Auth.SSOEnabled.ValueBoolPointer(),
+				SSORoles:       r.Auth.SSORoles,
+				SSOUsers:       r.Auth.SSOUsers,
+				WhitelistUsers: r.Auth.WhitelistUsers,
+			}
+			if !r.Auth.BasicAuthUser.IsNull() || !r.Auth.BasicAuthPassword.IsNull() {
+				auth.BasicAuth = &blueprint.BasicAuth{
+					User:     r.Auth.BasicAuthUser.ValueString(),
+					Password: r.Auth.BasicAuthPassword.ValueString(),
+				}
+			}
+			res.Auth = auth
+		}
+
+		for _, rule := range r.Rules {
+			res.Rules = append(res.Rules, blueprint.Rule{
+				Action: rule.Action.ValueString(),
+				Match:  rule.Match.ValueString(),
+				Value:  rule.Value.ValueString(),
+			})
+		}
+
+		for _, t := range r.Targets {
+			target := blueprint.Target{
+				Site:         t.Site.ValueString(),
+				Hostname:     t.Hostname.ValueString(),
+				Port:         int(t.Port.ValueInt64()),
+				Method:       t.Method.ValueString(),
+				Enabled:      t.Enabled.ValueBoolPointer(),
+				Path:         t.Path.ValueString(),
+				PathMatch:    t.PathMatch.ValueString(),
+				RewritePath:  t.RewritePath.ValueString(),
+				RewriteMatch: t.RewriteMatch.ValueString(),
+			}
+			if !t.Priority.IsNull() {
+				priority := int(t.Priority.ValueInt64())
+				target.Priority = &priority
+			}
+			res.Targets = append(res.Targets, target)
+		}
+
+		bp.ProxyResources[key] = res
+	}
+
+	if len(data.PrivateResources) > 0 {
+		bp.PrivateResources = make(map[string]blueprint.PrivateResource, len(data.PrivateResources))
+	}
+	for _, r := range data.PrivateResources {
+		key := r.Key.ValueString()
+		if _, ok := bp.PrivateResources[key]; ok {
+			diags.AddError("Duplicate Private Resource", fmt.Sprintf("The key %q is used by more than one private_resource block.", key))
+			continue
+		}
+
+		bp.PrivateResources[key] = blueprint.PrivateResource{
+			Name:        r.Name.ValueString(),
+			Mode:        r.Mode.ValueString(),
+			Site:        r.Site.ValueString(),
+			Destination: r.Destination.ValueString(),
+			Alias:       r.Alias.ValueString(),
+			Enabled:     r.Enabled.ValueBoolPointer(),
+			TCPPorts:    r.TCPPorts.ValueString(),
+			UDPPorts:    r.UDPPorts.ValueString(),
+			DisableICMP: r.DisableICMP.ValueBoolPointer(),
+			Roles:       r.Roles,
+			Users:       r.Users,
+		}
+	}
+
+	return bp, diags
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBlueprintDocumentDataSource_SensitiveOutputs(t *testing.T) {
+	var resp datasource.SchemaResponse
+	NewBlueprintDocumentDataSource().Schema(t.Context(), datasource.SchemaRequest{}, &resp)
+
+	for _, name := range []string{"json", "yaml", "base64", "labels"} {
+		if !resp.Schema.Attributes[name].IsSensitive() {
+			t.Errorf("%s can carry auth secrets and must be sensitive", name)
+		}
+	}
+}
+
+func TestAccBlueprintDocumentDataSource_Auth(t *testing.T) {
+	contains := func(want ...string) func(string) error {
+		return func(value string) error {
+			for _, w := range want {
+				if !strings.Contains(value, w) {
+					return fmt.Errorf("expected %q in %s", w, value)
+				}
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueprintDocumentAuthConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.pangolin_blueprint_document.test", "json",
+						contains(`"pincode":"123456"`, `"password":"hunter2"`, `"basic-auth":{"user":"admin","password":"s3cret"}`)),
+					resource.TestCheckResourceAttrWith("data.pangolin_blueprint_document.test", "yaml",
+						contains("pincode: \"123456\"", "password: hunter2")),
+					resource.TestCheckResourceAttrWith("data.pangolin_blueprint_document.test", "base64", func(value string) error {
+						decoded, err := base64.StdEncoding.DecodeString(value)
+						if err != nil {
+							return err
+						}
+						return contains(`"password":"hunter2"`)(string(decoded))
+					}),
+					resource.TestCheckResourceAttr("data.pangolin_blueprint_document.test", "labels.pangolin.proxy-resources.web.auth.pincode", "123456"),
+					resource.TestCheckResourceAttr("data.pangolin_blueprint_document.test", "labels.pangolin.proxy-resources.web.auth.basic-auth.password", "s3cret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlueprintDocumentAuthConfig() string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_blueprint_document" "test" {
+  proxy_resource {
+    key         = "web"
+    name        = "Web"
+    protocol    = "http"
+    full_domain = "web.example.com"
+
+    auth {
+      pincode             = "123456"
+      password            = "hunter2"
+      basic_auth_user     = "admin"
+      basic_auth_password = "s3cret"
+    }
+
+    target {
+      site     = "main"
+      hostname = "web"
+      port     = 8080
+    }
+  }
+}
+`, testURL, testToken)
+}
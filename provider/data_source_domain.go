@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &domainDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &domainDataSource{}
+
+func NewDomainDataSource() datasource.DataSource {
+	return &domainDataSource{}
+}
+
+type domainDataSource struct {
+	client *client.Client
+}
+
+type domainDataSourceModel struct {
+	ID                 types.String     `tfsdk:"id"`
+	OrgID              types.String     `tfsdk:"org_id"`
+	BaseDomain         types.String     `tfsdk:"base_domain"`
+	Type               types.String     `tfsdk:"type"`
+	Verified           types.Bool       `tfsdk:"verified"`
+	Failed             types.Bool       `tfsdk:"failed"`
+	ConfigManaged      types.Bool       `tfsdk:"config_managed"`
+	CertResolver       types.String     `tfsdk:"cert_resolver"`
+	PreferWildcardCert types.Bool       `tfsdk:"prefer_wildcard_cert"`
+	DNSRecords         []dnsRecordModel `tfsdk:"dns_records"`
+}
+
+type dnsRecordModel struct {
+	Type     types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	Value    types.String `tfsdk:"value"`
+	Verified types.Bool   `tfsdk:"verified"`
+}
+
+func (d *domainDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (d *domainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch a domain of an organization by ID or base domain name, along with the DNS records Pangolin expects for it. Exactly one of `id` or `base_domain` must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the domain, as used by `pangolin_resource.domain_id`.",
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"base_domain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The base domain name, e.g. `example.com`. Matching is case-insensitive.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "How the domain is delegated: `ns`, `cname` or `wildcard`.",
+			},
+			"verified": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether Pangolin has verified the domain's DNS records.",
+			},
+			"failed": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether verification of the domain failed.",
+			},
+			"config_managed": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the domain is defined in Pangolin's configuration file.",
+			},
+			"cert_resolver": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The certificate resolver used for the domain.",
+			},
+			"prefer_wildcard_cert": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether a wildcard certificate is preferred for the domain.",
+			},
+			"dns_records": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The DNS records that must exist for the domain to verify.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The record type, e.g. `NS`, `CNAME` or `A`.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The fully qualified record name.",
+						},
+						"value": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The record value.",
+						},
+						"verified": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether Pangolin has seen the record.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *domainDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("base_domain"),
+		),
+	}
+}
+
+func (d *domainDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *domainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data domainDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueString()
+
+	var found *client.Domain
+	var err error
+	if !data.ID.IsNull() {
+		found, err = d.client.GetDomain(ctx, orgID, data.ID.ValueString())
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Domain not found", fmt.Sprintf("Could not find domain with ID %q in organization %q", data.ID.ValueString(), orgID))
+			return
+		}
+	} else {
+		found, err = d.findDomainByName(ctx, orgID, data.BaseDomain.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading domain", err.Error())
+		return
+	}
+	if found == nil {
+		resp.Diagnostics.AddError("Domain not found", fmt.Sprintf("Could not find domain %q in organization %q", data.BaseDomain.ValueString(), orgID))
+		return
+	}
+
+	dom := flattenDomain(*found)
+	data.ID = dom.ID
+	if data.BaseDomain.IsNull() {
+		data.BaseDomain = dom.BaseDomain
+	}
+	data.Type = dom.Type
+	data.Verified = dom.Verified
+	data.Failed = dom.Failed
+	data.ConfigManaged = dom.ConfigManaged
+	data.CertResolver = dom.CertResolver
+	data.PreferWildcardCert = dom.PreferWildcardCert
+
+	records, err := d.client.GetDomainDNSRecords(ctx, orgID, found.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading DNS records", err.Error())
+		return
+	}
+
+	data.DNSRecords = make([]dnsRecordModel, 0, len(records))
+	for _, r := range records {
+		data.DNSRecords = append(data.DNSRecords, dnsRecordModel{
+			Type:     types.StringValue(r.RecordType),
+			Name:     types.StringValue(r.BaseDomain),
+			Value:    types.StringValue(r.Value),
+			Verified: types.BoolValue(r.Verified),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findDomainByName returns the domain with the base domain name, or nil.
+func (d *domainDataSource) findDomainByName(ctx context.Context, orgID string, baseDomain string) (*client.Domain, error) {
+	domains, err := d.client.ListDomains(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range domains {
+		if strings.EqualFold(domains[i].BaseDomain, baseDomain) {
+			return &domains[i], nil
+		}
+	}
+	return nil, nil
+}
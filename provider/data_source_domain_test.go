@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The fake server seeds the domain "local" with base domain test-tf.localhost.
+
+func TestAccDomainDataSource_Basic(t *testing.T) {
+	testAccFake(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainDataSourceConfig(`base_domain = "TEST-TF.localhost"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pangolin_domain.test", "id", "local"),
+					resource.TestCheckResourceAttr("data.pangolin_domain.test", "type", "wildcard"),
+					resource.TestCheckResourceAttr("data.pangolin_domain.test", "verified", "true"),
+					resource.TestCheckResourceAttr("data.pangolin_domain.test", "dns_records.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_domain.test", "dns_records.0.name", "*.test-tf.localhost"),
+				),
+			},
+			{
+				Config: testAccDomainDataSourceConfig(`id = "local"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pangolin_domain.test", "base_domain", "test-tf.localhost"),
+					resource.TestCheckResourceAttr("data.pangolin_domain.test", "config_managed", "true"),
+					resource.TestCheckResourceAttr("data.pangolin_domain.test", "dns_records.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDomainDataSource_NotFound(t *testing.T) {
+	testAccFake(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainDataSourceConfig(`base_domain = "missing.localhost"`),
+				ExpectError: regexp.MustCompile(`Domain not found`),
+			},
+			{
+				Config:      testAccDomainDataSourceConfig(`id = "missing"`),
+				ExpectError: regexp.MustCompile(`Domain not found`),
+			},
+		},
+	})
+}
+
+func TestAccDomainsDataSource_Basic(t *testing.T) {
+	testAccFake(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_domains" "test" {
+  org_id = %[3]q
+}
+`, testURL, testToken, testOrgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pangolin_domains.test", "domains.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_domains.test", "domains.0.id", "local"),
+					resource.TestCheckResourceAttr("data.pangolin_domains.test", "domains.0.base_domain", "test-tf.localhost"),
+					resource.TestCheckResourceAttr("data.pangolin_domains.test", "domains.0.verified", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainDataSourceConfig(lookup string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_domain" "test" {
+  org_id = %[3]q
+  %[4]s
+}
+`, testURL, testToken, testOrgID, lookup)
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &domainsDataSource{}
+
+func NewDomainsDataSource() datasource.DataSource {
+	return &domainsDataSource{}
+}
+
+type domainsDataSource struct {
+	client *client.Client
+}
+
+type domainsDataSourceModel struct {
+	OrgID   types.String  `tfsdk:"org_id"`
+	Domains []domainModel `tfsdk:"domains"`
+}
+
+type domainModel struct {
+	ID                 types.String `tfsdk:"id"`
+	BaseDomain         types.String `tfsdk:"base_domain"`
+	Type               types.String `tfsdk:"type"`
+	Verified           types.Bool   `tfsdk:"verified"`
+	Failed             types.Bool   `tfsdk:"failed"`
+	ConfigManaged      types.Bool   `tfsdk:"config_managed"`
+	CertResolver       types.String `tfsdk:"cert_resolver"`
+	PreferWildcardCert types.Bool   `tfsdk:"prefer_wildcard_cert"`
+}
+
+func flattenDomain(d client.Domain) domainModel {
+	return domainModel{
+		ID:                 types.StringValue(d.ID),
+		BaseDomain:         types.StringValue(d.BaseDomain),
+		Type:               types.StringValue(d.Type),
+		Verified:           types.BoolValue(d.Verified),
+		Failed:             types.BoolValue(d.Failed),
+		ConfigManaged:      types.BoolValue(d.ConfigManaged),
+		CertResolver:       types.StringPointerValue(d.CertResolver),
+		PreferWildcardCert: types.BoolPointerValue(d.PreferWildcardCert),
+	}
+}
+
+func (d *domainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func (d *domainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch all domains of an organization.",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"domains": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The domains of the organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the domain, as used by `pangolin_resource.domain_id`.",
+						},
+						"base_domain": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The base domain name, e.g. `example.com`.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "How the domain is delegated: `ns`, `cname` or `wildcard`.",
+						},
+						"verified": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether Pangolin has verified the domain's DNS records.",
+						},
+						"failed": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether verification of the domain failed.",
+						},
+						"config_managed": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the domain is defined in Pangolin's configuration file.",
+						},
+						"cert_resolver": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The certificate resolver used for the domain.",
+						},
+						"prefer_wildcard_cert": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether a wildcard certificate is preferred for the domain.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *domainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *domainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data domainsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains, err := d.client.ListDomains(ctx, data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing domains", err.Error())
+		return
+	}
+
+	data.Domains = make([]domainModel, 0, len(domains))
+	for _, dom := range domains {
+		data.Domains = append(data.Domains, flattenDomain(dom))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
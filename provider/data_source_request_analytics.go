@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &requestAnalyticsDataSource{}
+
+func NewRequestAnalyticsDataSource() datasource.DataSource {
+	return &requestAnalyticsDataSource{}
+}
+
+type requestAnalyticsDataSource struct {
+	client *client.Client
+}
+
+type requestAnalyticsDataSourceModel struct {
+	OrgID         types.String             `tfsdk:"org_id"`
+	TimeStart     types.String             `tfsdk:"time_start"`
+	TimeEnd       types.String             `tfsdk:"time_end"`
+	Since         types.String             `tfsdk:"since"`
+	ResourceID    types.Int64              `tfsdk:"resource_id"`
+	Countries     []string                 `tfsdk:"countries"`
+	TotalRequests types.Int64              `tfsdk:"total_requests"`
+	TotalBlocked  types.Int64              `tfsdk:"total_blocked"`
+	BlockedRatio  types.Float64            `tfsdk:"blocked_ratio"`
+	PerCountry    []countryRequestModel    `tfsdk:"per_country"`
+	PerDay        []dailyRequestCountModel `tfsdk:"per_day"`
+}
+
+type countryRequestModel struct {
+	Country types.String `tfsdk:"country"`
+	Count   types.Int64  `tfsdk:"count"`
+}
+
+type dailyRequestCountModel struct {
+	Day     types.String `tfsdk:"day"`
+	Allowed types.Int64  `tfsdk:"allowed"`
+	Blocked types.Int64  `tfsdk:"blocked"`
+	Total   types.Int64  `tfsdk:"total"`
+}
+
+func (d *requestAnalyticsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_request_analytics"
+}
+
+func (d *requestAnalyticsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Summarizes the request audit log of an organization by day and by country. " +
+			"Without a time window Pangolin summarizes the last 7 days.",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"time_start": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The start of the time window as an RFC 3339 timestamp. Conflicts with `since`.",
+				Validators: []validator.String{
+					rfc3339Validator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("since")),
+				},
+			},
+			"time_end": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The end of the time window as an RFC 3339 timestamp. Defaults to now.",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"since": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Start the time window this long before now, e.g. `24h`.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"resource_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only summarize requests to this resource.",
+			},
+			"countries": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Only report these ISO 3166 country codes in `per_country`. The totals always cover every country.",
+			},
+			"total_requests": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of requests in the window.",
+			},
+			"total_blocked": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of blocked requests in the window.",
+			},
+			"blocked_ratio": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The share of requests that were blocked, between 0 and 1.",
+			},
+			"per_country": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The number of requests per country.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"country": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The country code.",
+						},
+						"count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of requests.",
+						},
+					},
+				},
+			},
+			"per_day": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The number of requests per day.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The day, e.g. `2025-01-02`.",
+						},
+						"allowed": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of allowed requests.",
+						},
+						"blocked": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of blocked requests.",
+						},
+						"total": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of requests.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *requestAnalyticsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *requestAnalyticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data requestAnalyticsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, diags := requestLogQuery(data.TimeStart, data.TimeEnd, data.Since, data.ResourceID, time.Now())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	analytics, err := d.client.GetRequestAnalytics(ctx, data.OrgID.ValueString(), query)
+	if err != nil {
+		resp.Diagnostics.AddError("Error querying request analytics", err.Error())
+		return
+	}
+
+	countries := make(map[string]bool, len(data.Countries))
+	for _, c := range data.Countries {
+		countries[c] = true
+	}
+
+	data.PerCountry = []countryRequestModel{}
+	for _, c := range analytics.RequestsPerCountry {
+		if len(countries) > 0 && !countries[c.Code] {
+			continue
+		}
+		data.PerCountry = append(data.PerCountry, countryRequestModel{
+			Country: types.StringValue(c.Code),
+			Count:   types.Int64Value(int64(c.Count)),
+		})
+	}
+
+	data.PerDay = make([]dailyRequestCountModel, 0, len(analytics.RequestsPerDay))
+	for _, day := range analytics.RequestsPerDay {
+		data.PerDay = append(data.PerDay, dailyRequestCountModel{
+			Day:     types.StringValue(day.Day),
+			Allowed: types.Int64Value(int64(day.AllowedCount)),
+			Blocked: types.Int64Value(int64(day.BlockedCount)),
+			Total:   types.Int64Value(int64(day.TotalCount)),
+		})
+	}
+
+	data.TotalRequests = types.Int64Value(int64(analytics.TotalRequests))
+	data.TotalBlocked = types.Int64Value(int64(analytics.TotalBlocked))
+	data.BlockedRatio = types.Float64Value(0)
+	if analytics.TotalRequests > 0 {
+		data.BlockedRatio = types.Float64Value(float64(analytics.TotalBlocked) / float64(analytics.TotalRequests))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/fakeserver"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRequestAnalyticsDataSource_Basic(t *testing.T) {
+	const orgID = "request-analytics-ds"
+	fake := testAccFake(t)
+	fake.AddOrg(orgID)
+	day1 := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC).Unix()
+	day2 := time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC).Unix()
+	app, api := 1, 2
+	de, us, fr := "DE", "US", "FR"
+	for _, entry := range []fakeserver.RequestLog{
+		{Timestamp: day1, Action: true, Location: &de, ResourceID: &app},
+		{Timestamp: day1 + 1, Action: false, Location: &de, ResourceID: &app},
+		{Timestamp: day1 + 2, Action: true, Location: &de, ResourceID: &api},
+		{Timestamp: day2, Action: false, Location: &us, ResourceID: &api},
+		{Timestamp: day2 + 1, Action: true, Location: &fr, ResourceID: &app},
+	} {
+		fake.AddRequestLog(orgID, entry)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRequestAnalyticsDataSourceConfig(orgID, app),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "total_requests", "5"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "total_blocked", "2"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "blocked_ratio", "0.4"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "per_day.#", "2"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "per_day.0.day", "2025-06-01"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "per_day.0.allowed", "2"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "per_day.0.blocked", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "per_day.0.total", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "per_day.1.day", "2025-06-02"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "per_day.1.total", "2"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "per_country.#", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "per_country.0.country", "DE"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.all", "per_country.0.count", "3"),
+
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.app", "total_requests", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.app", "total_blocked", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.app", "per_country.#", "2"),
+
+					// The country filter narrows per_country but not the totals.
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.germany", "total_requests", "5"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.germany", "per_country.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.germany", "per_country.0.country", "DE"),
+
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.empty", "total_requests", "0"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.empty", "blocked_ratio", "0"),
+					resource.TestCheckResourceAttr("data.pangolin_request_analytics.empty", "per_day.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRequestAnalyticsDataSourceConfig(orgID string, resourceID int) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_request_analytics" "all" {
+  org_id     = %[3]q
+  time_start = "2025-06-01T00:00:00Z"
+  time_end   = "2025-06-03T00:00:00Z"
+}
+
+data "pangolin_request_analytics" "app" {
+  org_id      = %[3]q
+  time_start  = "2025-06-01T00:00:00Z"
+  time_end    = "2025-06-03T00:00:00Z"
+  resource_id = %[4]d
+}
+
+data "pangolin_request_analytics" "germany" {
+  org_id     = %[3]q
+  time_start = "2025-06-01T00:00:00Z"
+  time_end   = "2025-06-03T00:00:00Z"
+  countries  = ["DE"]
+}
+
+data "pangolin_request_analytics" "empty" {
+  org_id     = %[3]q
+  time_start = "2025-05-01T00:00:00Z"
+  time_end   = "2025-05-02T00:00:00Z"
+}
+`, testURL, testToken, orgID, resourceID)
+}
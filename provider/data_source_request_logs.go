@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &requestLogsDataSource{}
+
+// defaultMaxLogEntries bounds how many log entries are stored in state.
+const defaultMaxLogEntries = 1000
+
+func NewRequestLogsDataSource() datasource.DataSource {
+	return &requestLogsDataSource{}
+}
+
+type requestLogsDataSource struct {
+	client *client.Client
+}
+
+type requestLogsDataSourceModel struct {
+	OrgID        types.String      `tfsdk:"org_id"`
+	TimeStart    types.String      `tfsdk:"time_start"`
+	TimeEnd      types.String      `tfsdk:"time_end"`
+	Since        types.String      `tfsdk:"since"`
+	ResourceID   types.Int64       `tfsdk:"resource_id"`
+	Action       types.String      `tfsdk:"action"`
+	Country      types.String      `tfsdk:"country"`
+	Method       types.String      `tfsdk:"method"`
+	Host         types.String      `tfsdk:"host"`
+	Path         types.String      `tfsdk:"path"`
+	Actor        types.String      `tfsdk:"actor"`
+	MaxEntries   types.Int64       `tfsdk:"max_entries"`
+	EntryCount   types.Int64       `tfsdk:"entry_count"`
+	AllowedCount types.Int64       `tfsdk:"allowed_count"`
+	BlockedCount types.Int64       `tfsdk:"blocked_count"`
+	Entries      []requestLogModel `tfsdk:"entries"`
+}
+
+type requestLogModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Timestamp  types.String `tfsdk:"timestamp"`
+	Action     types.String `tfsdk:"action"`
+	Reason     types.Int64  `tfsdk:"reason"`
+	ActorType  types.String `tfsdk:"actor_type"`
+	Actor      types.String `tfsdk:"actor"`
+	ResourceID types.Int64  `tfsdk:"resource_id"`
+	IP         types.String `tfsdk:"ip"`
+	Country    types.String `tfsdk:"country"`
+	Method     types.String `tfsdk:"method"`
+	Host       types.String `tfsdk:"host"`
+	Path       types.String `tfsdk:"path"`
+}
+
+func (d *requestLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_request_logs"
+}
+
+func (d *requestLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Queries the request audit log of an organization, newest entries first. " +
+			"Results are paged through transparently up to `max_entries`. Without a time window Pangolin returns the last 7 days.",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"time_start": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The start of the time window as an RFC 3339 timestamp. Conflicts with `since`.",
+				Validators: []validator.String{
+					rfc3339Validator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("since")),
+				},
+			},
+			"time_end": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The end of the time window as an RFC 3339 timestamp. Defaults to now.",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"since": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Start the time window this long before now, e.g. `24h`.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"resource_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return requests to this resource.",
+			},
+			"action": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return `allowed` or `blocked` requests.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("allowed", "blocked"),
+				},
+			},
+			"country": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return requests from this ISO 3166 country code, e.g. `DE`.",
+			},
+			"method": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return requests with this HTTP method.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.RequestMethodValues()...),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return requests to this host.",
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return requests to this path.",
+			},
+			"actor": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return requests made by this actor.",
+			},
+			"max_entries": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of entries to return. Defaults to `%d`.", defaultMaxLogEntries),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"entry_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of entries returned.",
+			},
+			"allowed_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of allowed requests among the returned entries.",
+			},
+			"blocked_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of blocked requests among the returned entries.",
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching log entries.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the entry.",
+						},
+						"timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the request was made, as an RFC 3339 timestamp.",
+						},
+						"action": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the request was `allowed` or `blocked`.",
+						},
+						"reason": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Pangolin's numeric reason code for the decision.",
+						},
+						"actor_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The kind of actor that made the request.",
+						},
+						"actor": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The actor that made the request.",
+						},
+						"resource_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the requested resource.",
+						},
+						"ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The client IP address.",
+						},
+						"country": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The country code of the client.",
+						},
+						"method": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The HTTP method.",
+						},
+						"host": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The requested host.",
+						},
+						"path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The requested path.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *requestLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *requestLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data requestLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, diags := requestLogQuery(data.TimeStart, data.TimeEnd, data.Since, data.ResourceID, time.Now())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.Action.IsNull() {
+		allowed := data.Action.ValueString() == "allowed"
+		query.Action = &allowed
+	}
+	query.Location = data.Country.ValueString()
+	query.Method = data.Method.ValueString()
+	query.Host = data.Host.ValueString()
+	query.Path = data.Path.ValueString()
+	query.Actor = data.Actor.ValueString()
+
+	maxEntries := defaultMaxLogEntries
+	if !data.MaxEntries.IsNull() {
+		maxEntries = int(data.MaxEntries.ValueInt64())
+	}
+
+	logs, err := d.client.ListRequestLogs(ctx, data.OrgID.ValueString(), query, maxEntries)
+	if err != nil {
+		resp.Diagnostics.AddError("Error querying request logs", err.Error())
+		return
+	}
+
+	var allowed, blocked int64
+	data.Entries = make([]requestLogModel, 0, len(logs))
+	for _, l := range logs {
+		action := "blocked"
+		if l.Action {
+			action = "allowed"
+			allowed++
+		} else {
+			blocked++
+		}
+
+		entry := requestLogModel{
+			ID:         types.Int64Value(int64(l.ID)),
+			Timestamp:  types.StringValue(time.Unix(l.Timestamp, 0).UTC().Format(time.RFC3339)),
+			Action:     types.StringValue(action),
+			Reason:     types.Int64Value(int64(l.Reason)),
+			ActorType:  types.StringPointerValue(l.ActorType),
+			Actor:      types.StringPointerValue(l.Actor),
+			ResourceID: types.Int64Null(),
+			IP:         types.StringPointerValue(l.IP),
+			Country:    types.StringPointerValue(l.Location),
+			Method:     types.StringPointerValue(l.Method),
+			Host:       types.StringPointerValue(l.Host),
+			Path:       types.StringPointerValue(l.Path),
+		}
+		if l.ResourceID != nil {
+			entry.ResourceID = types.Int64Value(int64(*l.ResourceID))
+		}
+		data.Entries = append(data.Entries, entry)
+	}
+
+	data.EntryCount = types.Int64Value(int64(len(logs)))
+	data.AllowedCount = types.Int64Value(allowed)
+	data.BlockedCount = types.Int64Value(blocked)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// requestLogQuery builds the time window and resource filter shared by the
+// request log data sources. since is resolved relative to now.
+func requestLogQuery(timeStart, timeEnd, since types.String, resourceID types.Int64, now time.Time) (client.RequestLogQuery, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var query client.RequestLogQuery
+
+	if !timeStart.IsNull() {
+		t, err := time.Parse(time.RFC3339, timeStart.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("time_start"), "Invalid Timestamp", err.Error())
+		}
+		query.TimeStart = t
+	}
+	if !timeEnd.IsNull() {
+		t, err := time.Parse(time.RFC3339, timeEnd.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("time_end"), "Invalid Timestamp", err.Error())
+		}
+		query.TimeEnd = t
+	}
+	if !since.IsNull() {
+		dur, err := time.ParseDuration(since.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("since"), "Invalid Duration", err.Error())
+		}
+		query.TimeStart = now.Add(-dur)
+	}
+	if !query.TimeStart.IsZero() && !query.TimeEnd.IsZero() && !query.TimeStart.Before(query.TimeEnd) {
+		diags.AddAttributeError(path.Root("time_end"), "Invalid Time Window", "The end of the time window must be after its start.")
+	}
+	if !resourceID.IsNull() {
+		query.ResourceID = int(resourceID.ValueInt64())
+	}
+
+	return query, diags
+}
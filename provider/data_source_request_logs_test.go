@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/fakeserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRequestLogQuery(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	q, diags := requestLogQuery(types.StringNull(), types.StringNull(), types.StringValue("24h"), types.Int64Value(3), now)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !q.TimeStart.Equal(now.Add(-24*time.Hour)) || !q.TimeEnd.IsZero() || q.ResourceID != 3 {
+		t.Errorf("unexpected query: %+v", q)
+	}
+
+	_, diags = requestLogQuery(types.StringValue("2025-06-02T00:00:00Z"), types.StringValue("2025-06-01T00:00:00Z"), types.StringNull(), types.Int64Null(), now)
+	if !diags.HasError() {
+		t.Error("expected an error for a window that ends before it starts")
+	}
+}
+
+func TestAccRequestLogsDataSource_Filters(t *testing.T) {
+	const orgID = "request-logs-ds"
+	fake := testAccFake(t)
+	fake.AddOrg(orgID)
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	country, method, host, path := "DE", "GET", "app.example.com", "/login"
+	// More entries than the endpoint returns per page, two per second and
+	// every fourth blocked, so reading them all pages back by timeEnd.
+	const total = 1200
+	var newest int
+	for i := range total {
+		newest = fake.AddRequestLog(orgID, fakeserver.RequestLog{
+			Timestamp: start.Unix() + int64(i/2),
+			Action:    i%4 != 0,
+			Location:  &country,
+			Method:    &method,
+			Host:      &host,
+			Path:      &path,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRequestLogsDataSourceConfig(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.all", "entry_count", fmt.Sprint(total)),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.all", "entries.#", fmt.Sprint(total)),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.all", "allowed_count", fmt.Sprint(total*3/4)),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.all", "blocked_count", fmt.Sprint(total/4)),
+
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.capped", "entry_count", "5"),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.capped", "entries.#", "5"),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.capped", "entries.0.id", fmt.Sprint(newest)),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.capped", "entries.0.timestamp", "2025-06-01T12:09:59Z"),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.capped", "entries.0.action", "allowed"),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.capped", "entries.0.country", "DE"),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.capped", "entries.0.method", "GET"),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.capped", "entries.0.host", "app.example.com"),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.capped", "entries.0.path", "/login"),
+					resource.TestCheckNoResourceAttr("data.pangolin_request_logs.capped", "entries.0.resource_id"),
+
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.blocked", "entry_count", fmt.Sprint(total/4)),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.blocked", "allowed_count", "0"),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.blocked", "blocked_count", fmt.Sprint(total/4)),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.blocked", "entries.0.action", "blocked"),
+
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.none", "entry_count", "0"),
+					resource.TestCheckResourceAttr("data.pangolin_request_logs.none", "entries.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRequestLogsDataSourceConfig(orgID string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_request_logs" "all" {
+  org_id      = %[3]q
+  time_start  = "2025-06-01T12:00:00Z"
+  time_end    = "2025-06-01T13:00:00Z"
+  max_entries = 2000
+}
+
+data "pangolin_request_logs" "capped" {
+  org_id      = %[3]q
+  time_start  = "2025-06-01T12:00:00Z"
+  time_end    = "2025-06-01T13:00:00Z"
+  max_entries = 5
+}
+
+data "pangolin_request_logs" "blocked" {
+  org_id     = %[3]q
+  time_start = "2025-06-01T12:00:00Z"
+  time_end   = "2025-06-01T13:00:00Z"
+  action     = "blocked"
+}
+
+data "pangolin_request_logs" "none" {
+  org_id     = %[3]q
+  time_start = "2025-06-01T12:00:00Z"
+  time_end   = "2025-06-01T13:00:00Z"
+  path       = "/admin"
+}
+`, testURL, testToken, orgID)
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &resourceDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &resourceDataSource{}
+
+func NewResourceDataSource() datasource.DataSource {
+	return &resourceDataSource{}
+}
+
+type resourceDataSource struct {
+	client *client.Client
+}
+
+type resourceDataSourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	OrgID      types.String `tfsdk:"org_id"`
+	NiceID     types.String `tfsdk:"nice_id"`
+	Name       types.String `tfsdk:"name"`
+	FullDomain types.String `tfsdk:"full_domain"`
+	Protocol   types.String `tfsdk:"protocol"`
+	Http       types.Bool   `tfsdk:"http"`
+	Subdomain  types.String `tfsdk:"subdomain"`
+	DomainID   types.String `tfsdk:"domain_id"`
+	ProxyPort  types.Int64  `tfsdk:"proxy_port"`
+	SSL        types.Bool   `tfsdk:"ssl"`
+	SSO        types.Bool   `tfsdk:"sso"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *resourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource"
+}
+
+func (d *resourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch a public resource by ID, nice ID, name or full domain. Exactly one of `id`, `nice_id`, `name` or `full_domain` must be set; a name shared by several resources is an error.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the resource.",
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"nice_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The human readable ID of the resource.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the resource.",
+			},
+			"full_domain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The full domain of an HTTP resource, e.g. `app.example.com`. Matched case-insensitively.",
+			},
+			"protocol": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The protocol of the resource: `tcp` or `udp`.",
+			},
+			"http": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the resource is an HTTP resource.",
+			},
+			"subdomain": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The subdomain of an HTTP resource.",
+			},
+			"domain_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the domain of an HTTP resource.",
+			},
+			"proxy_port": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The public port of a raw TCP or UDP resource.",
+			},
+			"ssl": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether TLS is terminated for the resource.",
+			},
+			"sso": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the resource requires Pangolin authentication.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the resource is enabled.",
+			},
+		},
+	}
+}
+
+func (d *resourceDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("nice_id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("full_domain"),
+		),
+	}
+}
+
+func (d *resourceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *resourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data resourceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueString()
+
+	var res *client.Resource
+	var err error
+	switch {
+	case !data.ID.IsNull():
+		res, err = d.client.GetResource(ctx, int(data.ID.ValueInt64()))
+	case !data.NiceID.IsNull():
+		res, err = d.client.GetResourceByNiceID(ctx, orgID, data.NiceID.ValueString())
+	case !data.FullDomain.IsNull():
+		res, err = findResourceByFullDomain(ctx, d.client, orgID, data.FullDomain.ValueString())
+	default:
+		name := data.Name.ValueString()
+		res, err = findResource(ctx, d.client, orgID, fmt.Sprintf("name %q", name), func(r client.Resource) bool {
+			return r.Name == name
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Resources are fetched by their global ID, which ignores the organization.
+	checkObjectOrg(&resp.Diagnostics, "Resource", res.ID, res.OrgID, orgID)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.Int64Value(int64(res.ID))
+	data.NiceID = types.StringValue(res.NiceID)
+	data.Name = types.StringValue(res.Name)
+	data.FullDomain = types.StringValue(res.FullDomain)
+	data.Protocol = types.StringValue(res.Protocol)
+	data.Http = types.BoolValue(res.Http)
+	data.Subdomain = types.StringValue(res.Subdomain)
+	data.DomainID = types.StringValue(res.DomainID)
+	data.ProxyPort = types.Int64Null()
+	if res.ProxyPort != nil {
+		data.ProxyPort = types.Int64Value(int64(*res.ProxyPort))
+	}
+	data.SSL = types.BoolPointerValue(res.SSL)
+	data.SSO = types.BoolPointerValue(res.SSO)
+	data.Enabled = types.BoolValue(res.Enabled == nil || *res.Enabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findResourceByFullDomain resolves a host name to the resource serving it.
+func findResourceByFullDomain(ctx context.Context, c *client.Client, orgID string, host string) (*client.Resource, error) {
+	host = strings.TrimSuffix(host, ".")
+	return findResource(ctx, c, orgID, fmt.Sprintf("full domain %q", host), func(r client.Resource) bool {
+		return strings.EqualFold(r.FullDomain, host)
+	})
+}
+
+// findResource returns the single resource of an organization accepted by
+// match, failing when none or several are. what describes the match in errors.
+func findResource(ctx context.Context, c *client.Client, orgID string, what string, match func(client.Resource) bool) (*client.Resource, error) {
+	resources, err := c.ListResources(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []client.Resource
+	for _, r := range resources {
+		if match(r) {
+			matches = append(matches, r)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("could not find resource with %s in organization %q", what, orgID)
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, r := range matches {
+			ids[i] = fmt.Sprintf("%d (%s)", r.ID, r.NiceID)
+		}
+		return nil, fmt.Errorf("%d resources match %s in organization %q: %s; use id or nice_id instead", len(matches), what, orgID, strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceDataSource_Lookups(t *testing.T) {
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair("data.pangolin_resource.by_id", "id", "pangolin_resource.test", "id"),
+		resource.TestCheckResourceAttr("data.pangolin_resource.by_id", "name", "Lookup App"),
+		resource.TestCheckResourceAttr("data.pangolin_resource.by_id", "subdomain", "lookup-app"),
+		resource.TestCheckResourceAttr("data.pangolin_resource.by_id", "domain_id", "local"),
+		resource.TestCheckResourceAttr("data.pangolin_resource.by_id", "http", "true"),
+		resource.TestCheckResourceAttrSet("data.pangolin_resource.by_id", "nice_id"),
+		resource.TestCheckResourceAttrSet("data.pangolin_resource.by_id", "full_domain"),
+	}
+	for _, name := range []string{"by_nice_id", "by_full_domain", "by_name"} {
+		checks = append(checks, resource.TestCheckResourceAttrPair("data.pangolin_resource."+name, "id", "pangolin_resource.test", "id"))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDataSourceLookupsConfig(),
+				Check:  resource.ComposeAggregateTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccResourceDataSource_OtherOrg(t *testing.T) {
+	fake := testAccFake(t)
+	fake.AddOrg("res-ds-other")
+	fake.AddDomain("res-ds-other", "res-ds-other", "res-ds-other.localhost")
+
+	res, err := client.NewClient(testURL, testToken).CreateResource(t.Context(), "res-ds-other", &client.Resource{
+		Name:      "Elsewhere",
+		Protocol:  "tcp",
+		Http:      true,
+		Subdomain: "elsewhere",
+		DomainID:  "res-ds-other",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_resource" "test" {
+  org_id = %[3]q
+  id     = %[4]d
+}
+`, testURL, testToken, testOrgID, res.ID),
+				ExpectError: regexp.MustCompile(`Resource Not In Organization`),
+			},
+		},
+	})
+}
+
+func testAccResourceDataSourceLookupsConfig() string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+resource "pangolin_resource" "test" {
+  org_id    = %[3]q
+  name      = "Lookup App"
+  protocol  = "tcp"
+  http      = true
+  subdomain = "lookup-app"
+  domain_id = "local"
+}
+
+data "pangolin_resource" "by_id" {
+  org_id = %[3]q
+  id     = pangolin_resource.test.id
+}
+
+data "pangolin_resource" "by_nice_id" {
+  org_id  = %[3]q
+  nice_id = data.pangolin_resource.by_id.nice_id
+}
+
+data "pangolin_resource" "by_full_domain" {
+  org_id      = %[3]q
+  full_domain = data.pangolin_resource.by_id.full_domain
+}
+
+data "pangolin_resource" "by_name" {
+  org_id = %[3]q
+  name   = pangolin_resource.test.name
+}
+`, testURL, testToken, testOrgID)
+}
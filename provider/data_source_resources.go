@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &resourcesDataSource{}
+
+func NewResourcesDataSource() datasource.DataSource {
+	return &resourcesDataSource{}
+}
+
+type resourcesDataSource struct {
+	client *client.Client
+}
+
+type resourcesDataSourceModel struct {
+	OrgID     types.String           `tfsdk:"org_id"`
+	NameRegex types.String           `tfsdk:"name_regex"`
+	Enabled   types.Bool             `tfsdk:"enabled"`
+	IDs       []types.Int64          `tfsdk:"ids"`
+	Resources []resourceSummaryModel `tfsdk:"resources"`
+}
+
+type resourceSummaryModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	NiceID     types.String `tfsdk:"nice_id"`
+	Name       types.String `tfsdk:"name"`
+	Protocol   types.String `tfsdk:"protocol"`
+	Http       types.Bool   `tfsdk:"http"`
+	FullDomain types.String `tfsdk:"full_domain"`
+	DomainID   types.String `tfsdk:"domain_id"`
+	ProxyPort  types.Int64  `tfsdk:"proxy_port"`
+	SSL        types.Bool   `tfsdk:"ssl"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *resourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources"
+}
+
+func (d *resourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch all public resources of an organization, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return resources whose name matches this regular expression.",
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return resources that are (or are not) enabled.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the matching resources.",
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching resources.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the resource.",
+						},
+						"nice_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The human readable ID of the resource.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the resource.",
+						},
+						"protocol": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The protocol of the resource.",
+						},
+						"http": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the resource is an HTTP resource.",
+						},
+						"full_domain": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The full domain of an HTTP resource.",
+						},
+						"domain_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the domain of an HTTP resource.",
+						},
+						"proxy_port": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The public port of a TCP or UDP resource.",
+						},
+						"ssl": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the resource is served over HTTPS.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the resource is enabled.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *resourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *resourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data resourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newNameFilter(data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resources, err := d.client.ListResources(ctx, data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing resources", err.Error())
+		return
+	}
+
+	data.IDs = []types.Int64{}
+	data.Resources = []resourceSummaryModel{}
+	for _, r := range resources {
+		enabled := r.Enabled == nil || *r.Enabled
+		if !filter.Match(r.Name) || !boolFilterMatches(data.Enabled, enabled) {
+			continue
+		}
+		data.IDs = append(data.IDs, types.Int64Value(int64(r.ID)))
+		data.Resources = append(data.Resources, flattenResourceSummary(r))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenResourceSummary(r client.Resource) resourceSummaryModel {
+	m := resourceSummaryModel{
+		ID:         types.Int64Value(int64(r.ID)),
+		NiceID:     types.StringValue(r.NiceID),
+		Name:       types.StringValue(r.Name),
+		Protocol:   types.StringValue(r.Protocol),
+		Http:       types.BoolValue(r.Http),
+		FullDomain: types.StringValue(r.FullDomain),
+		DomainID:   types.StringValue(r.DomainID),
+		ProxyPort:  types.Int64Null(),
+		SSL:        types.BoolPointerValue(r.SSL),
+		Enabled:    types.BoolValue(r.Enabled == nil || *r.Enabled),
+	}
+	if r.ProxyPort != nil {
+		m.ProxyPort = types.Int64Value(int64(*r.ProxyPort))
+	}
+	return m
+}
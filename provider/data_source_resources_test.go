@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourcesDataSource_Filters(t *testing.T) {
+	const orgID = "resources-ds"
+	fake := testAccFake(t)
+	fake.AddOrg(orgID)
+	fake.AddDomain(orgID, orgID, orgID+".localhost")
+
+	c := client.NewClient(testURL, testToken)
+	disabled := false
+	proxyPort := 2222
+	var ids []int
+	for _, res := range []*client.Resource{
+		{Name: "app-a", Protocol: "tcp", Http: true, Subdomain: "app-a", DomainID: orgID},
+		{Name: "app-b", Protocol: "tcp", Http: true, Subdomain: "app-b", DomainID: orgID, Enabled: &disabled},
+		{Name: "ssh", Protocol: "tcp", ProxyPort: &proxyPort},
+	} {
+		created, err := c.CreateResource(t.Context(), orgID, res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, created.ID)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pangolin_resources.all", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_resources.all", "resources.#", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_resources.all", "resources.2.name", "ssh"),
+					resource.TestCheckResourceAttr("data.pangolin_resources.all", "resources.2.http", "false"),
+					resource.TestCheckResourceAttr("data.pangolin_resources.all", "resources.2.proxy_port", "2222"),
+
+					resource.TestCheckResourceAttr("data.pangolin_resources.apps", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.pangolin_resources.apps", "ids.0", fmt.Sprint(ids[0])),
+					resource.TestCheckResourceAttr("data.pangolin_resources.apps", "ids.1", fmt.Sprint(ids[1])),
+					resource.TestCheckResourceAttr("data.pangolin_resources.apps", "resources.0.full_domain", "app-a."+orgID+".localhost"),
+
+					resource.TestCheckResourceAttr("data.pangolin_resources.disabled", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_resources.disabled", "ids.0", fmt.Sprint(ids[1])),
+					resource.TestCheckResourceAttr("data.pangolin_resources.disabled", "resources.0.enabled", "false"),
+
+					resource.TestCheckResourceAttr("data.pangolin_resources.enabled_apps", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_resources.enabled_apps", "ids.0", fmt.Sprint(ids[0])),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig(orgID string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_resources" "all" {
+  org_id = %[3]q
+}
+
+data "pangolin_resources" "apps" {
+  org_id     = %[3]q
+  name_regex = "^app-"
+}
+
+data "pangolin_resources" "disabled" {
+  org_id  = %[3]q
+  enabled = false
+}
+
+data "pangolin_resources" "enabled_apps" {
+  org_id     = %[3]q
+  name_regex = "^app-"
+  enabled    = true
+}
+`, testURL, testToken, orgID)
+}
@@ -3,14 +3,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &roleDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &roleDataSource{}
 
 func NewRoleDataSource() datasource.DataSource {
 	return &roleDataSource{}
@@ -21,10 +26,13 @@ type roleDataSource struct {
 }
 
 type roleDataSourceModel struct {
-	ID          types.Int64  `tfsdk:"id"`
-	OrgID       types.String `tfsdk:"org_id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID            types.Int64  `tfsdk:"id"`
+	OrgID         types.String `tfsdk:"org_id"`
+	Name          types.String `tfsdk:"name"`
+	IgnoreCase    types.Bool   `tfsdk:"ignore_case"`
+	Description   types.String `tfsdk:"description"`
+	IsAdmin       types.Bool   `tfsdk:"is_admin"`
+	MemberUserIDs []string     `tfsdk:"member_user_ids"`
 }
 
 func (d *roleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,28 +41,53 @@ func (d *roleDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 
 func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetch an organization role by name.",
+		MarkdownDescription: "Fetch an organization role by ID or name. Exactly one of `id` or `name` must be set.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
+				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "The ID of the role.",
 			},
 			"org_id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The ID of the organization.",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
 			},
 			"name": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: "The name of the role.",
 			},
+			"ignore_case": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Match `name` case-insensitively. It is an error if several roles match. Defaults to `false`.",
+			},
 			"description": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The description of the role.",
 			},
+			"is_admin": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether this is the organization's built-in admin role.",
+			},
+			"member_user_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the users holding the role, sorted.",
+			},
 		},
 	}
 }
 
+func (d *roleDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *roleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -74,20 +107,78 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	roles, err := d.client.ListRoles(data.OrgID.ValueString())
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueString()
+
+	var role *client.Role
+	var err error
+	if !data.ID.IsNull() {
+		role, err = d.client.GetRole(ctx, orgID, int(data.ID.ValueInt64()))
+	} else {
+		role, err = d.findRoleByName(ctx, orgID, data.Name.ValueString(), data.IgnoreCase.ValueBool())
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error listing roles", err.Error())
+		resp.Diagnostics.AddError("Error reading role", err.Error())
 		return
 	}
 
+	// Roles are fetched by their global ID, which ignores the organization.
+	checkObjectOrg(&resp.Diagnostics, "Role", role.ID, role.OrgID, orgID)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListOrgUsers(ctx, orgID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing organization users", err.Error())
+		return
+	}
+
+	data.MemberUserIDs = []string{}
+	for _, u := range users {
+		if u.RoleID == role.ID {
+			data.MemberUserIDs = append(data.MemberUserIDs, u.ID)
+		}
+	}
+	sort.Strings(data.MemberUserIDs)
+
+	data.ID = types.Int64Value(int64(role.ID))
+	data.Name = types.StringValue(role.Name)
+	data.Description = types.StringValue(role.Description)
+	data.IsAdmin = types.BoolValue(role.IsAdmin)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findRoleByName resolves a role name, failing when the name is missing or
+// matches several roles.
+func (d *roleDataSource) findRoleByName(ctx context.Context, orgID string, name string, ignoreCase bool) (*client.Role, error) {
+	roles, err := d.client.ListRoles(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []client.Role
 	for _, r := range roles {
-		if r.Name == data.Name.ValueString() {
-			data.ID = types.Int64Value(int64(r.ID))
-			data.Description = types.StringValue(r.Description)
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-			return
+		if r.Name == name || (ignoreCase && strings.EqualFold(r.Name, name)) {
+			matches = append(matches, r)
 		}
 	}
 
-	resp.Diagnostics.AddError("Role not found", fmt.Sprintf("Could not find role with name %q in organization %q", data.Name.ValueString(), data.OrgID.ValueString()))
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("could not find role with name %q in organization %q", name, orgID)
+	case 1:
+		return &matches[0], nil
+	default:
+		names := make([]string, len(matches))
+		for i, r := range matches {
+			names[i] = fmt.Sprintf("%q (%d)", r.Name, r.ID)
+		}
+		return nil, fmt.Errorf("%d roles match name %q in organization %q: %s; use id instead", len(matches), name, orgID, strings.Join(names, ", "))
+	}
 }
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleDataSource_Lookups(t *testing.T) {
+	fake := testAccFake(t)
+	member, _ := fake.RoleByName(testOrgID, "Member")
+
+	checks := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("data.pangolin_role.test", "id", fmt.Sprint(member.RoleID)),
+		resource.TestCheckResourceAttr("data.pangolin_role.test", "name", "Member"),
+		resource.TestCheckResourceAttr("data.pangolin_role.test", "is_admin", "false"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleDataSourceConfig(fmt.Sprintf("id = %d", member.RoleID)),
+				Check:  checks,
+			},
+			{
+				Config: testAccRoleDataSourceConfig(`name = "Member"`),
+				Check:  checks,
+			},
+			{
+				Config: testAccRoleDataSourceConfig(`
+  name        = "member"
+  ignore_case = true`),
+				Check: resource.TestCheckResourceAttr("data.pangolin_role.test", "id", fmt.Sprint(member.RoleID)),
+			},
+			{
+				Config:      testAccRoleDataSourceConfig(`name = "member"`),
+				ExpectError: regexp.MustCompile(`could not find role with name "member"`),
+			},
+		},
+	})
+}
+
+func TestAccRoleDataSource_OtherOrg(t *testing.T) {
+	fake := testAccFake(t)
+	fake.AddOrg("role-ds-other")
+	other, _ := fake.RoleByName("role-ds-other", "Member")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleDataSourceConfig(fmt.Sprintf("id = %d", other.RoleID)),
+				ExpectError: regexp.MustCompile(`Role Not In Organization`),
+			},
+		},
+	})
+}
+
+func testAccRoleDataSourceConfig(lookup string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_role" "test" {
+  org_id = %[3]q
+  %[4]s
+}
+`, testURL, testToken, testOrgID, lookup)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &rolesDataSource{}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+type rolesDataSource struct {
+	client *client.Client
+}
+
+type rolesDataSourceModel struct {
+	OrgID     types.String       `tfsdk:"org_id"`
+	NameRegex types.String       `tfsdk:"name_regex"`
+	IsAdmin   types.Bool         `tfsdk:"is_admin"`
+	IDs       []types.Int64      `tfsdk:"ids"`
+	Roles     []roleSummaryModel `tfsdk:"roles"`
+}
+
+type roleSummaryModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsAdmin     types.Bool   `tfsdk:"is_admin"`
+}
+
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch all roles of an organization, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return roles whose name matches this regular expression.",
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"is_admin": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the admin role (`true`) or every other role (`false`).",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the matching roles.",
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching roles.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the role.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the role.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the role.",
+						},
+						"is_admin": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether this is the organization's built-in admin role.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *rolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data rolesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newNameFilter(data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := d.client.ListRoles(ctx, data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing roles", err.Error())
+		return
+	}
+
+	data.IDs = []types.Int64{}
+	data.Roles = []roleSummaryModel{}
+	for _, r := range roles {
+		if !filter.Match(r.Name) || !boolFilterMatches(data.IsAdmin, r.IsAdmin) {
+			continue
+		}
+		data.IDs = append(data.IDs, types.Int64Value(int64(r.ID)))
+		data.Roles = append(data.Roles, roleSummaryModel{
+			ID:          types.Int64Value(int64(r.ID)),
+			Name:        types.StringValue(r.Name),
+			Description: types.StringValue(r.Description),
+			IsAdmin:     types.BoolValue(r.IsAdmin),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRolesDataSource_Filters(t *testing.T) {
+	const orgID = "roles-ds"
+	fake := testAccFake(t)
+	fake.AddOrg(orgID)
+	admin, _ := fake.RoleByName(orgID, "Admin")
+	member, _ := fake.RoleByName(orgID, "Member")
+	ops, err := client.NewClient(testURL, testToken).CreateRole(t.Context(), orgID, &client.Role{Name: "Ops", Description: "Operators"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolesDataSourceConfig(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "roles.#", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "roles.0.id", fmt.Sprint(admin.RoleID)),
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "roles.0.name", "Admin"),
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "roles.0.is_admin", "true"),
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "roles.1.id", fmt.Sprint(member.RoleID)),
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "roles.1.name", "Member"),
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "roles.1.is_admin", "false"),
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "roles.2.id", fmt.Sprint(ops.ID)),
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "roles.2.name", "Ops"),
+					resource.TestCheckResourceAttr("data.pangolin_roles.all", "roles.2.description", "Operators"),
+
+					resource.TestCheckResourceAttr("data.pangolin_roles.admins", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_roles.admins", "ids.0", fmt.Sprint(admin.RoleID)),
+
+					resource.TestCheckResourceAttr("data.pangolin_roles.others", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.pangolin_roles.others", "ids.0", fmt.Sprint(member.RoleID)),
+					resource.TestCheckResourceAttr("data.pangolin_roles.others", "ids.1", fmt.Sprint(ops.ID)),
+
+					resource.TestCheckResourceAttr("data.pangolin_roles.ops", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_roles.ops", "ids.0", fmt.Sprint(ops.ID)),
+				),
+			},
+		},
+	})
+}
+
+func testAccRolesDataSourceConfig(orgID string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_roles" "all" {
+  org_id = %[3]q
+}
+
+data "pangolin_roles" "admins" {
+  org_id   = %[3]q
+  is_admin = true
+}
+
+data "pangolin_roles" "others" {
+  org_id   = %[3]q
+  is_admin = false
+}
+
+data "pangolin_roles" "ops" {
+  org_id     = %[3]q
+  name_regex = "^Op"
+}
+`, testURL, testToken, orgID)
+}
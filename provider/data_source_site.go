@@ -3,14 +3,18 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &siteDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &siteDataSource{}
 
 func NewSiteDataSource() datasource.DataSource {
 	return &siteDataSource{}
@@ -21,9 +25,18 @@ type siteDataSource struct {
 }
 
 type siteDataSourceModel struct {
-	ID    types.Int64  `tfsdk:"id"`
-	OrgID types.String `tfsdk:"org_id"`
-	Name  types.String `tfsdk:"name"`
+	ID                  types.Int64   `tfsdk:"id"`
+	OrgID               types.String  `tfsdk:"org_id"`
+	NiceID              types.String  `tfsdk:"nice_id"`
+	Name                types.String  `tfsdk:"name"`
+	Type                types.String  `tfsdk:"type"`
+	Subnet              types.String  `tfsdk:"subnet"`
+	Address             types.String  `tfsdk:"address"`
+	Online              types.Bool    `tfsdk:"online"`
+	LastBandwidthUpdate types.String  `tfsdk:"last_bandwidth_update"`
+	MegabytesIn         types.Float64 `tfsdk:"megabytes_in"`
+	MegabytesOut        types.Float64 `tfsdk:"megabytes_out"`
+	DockerSocketEnabled types.Bool    `tfsdk:"docker_socket_enabled"`
 }
 
 func (d *siteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -32,24 +45,74 @@ func (d *siteDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 
 func (d *siteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetch a site by name.",
+		MarkdownDescription: "Fetch a site by ID, nice ID or name. Exactly one of `id`, `nice_id` or `name` must be set; a name shared by several sites is an error.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
+				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "The ID of the site.",
 			},
 			"org_id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The ID of the organization.",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"nice_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The human readable ID of the site.",
 			},
 			"name": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: "The name of the site.",
 			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the site, e.g. `newt` or `wireguard`.",
+			},
+			"subnet": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The subnet assigned to the site.",
+			},
+			"address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The tunnel address of the site.",
+			},
+			"online": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the site tunnel is connected.",
+			},
+			"last_bandwidth_update": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The last time Pangolin updated the traffic counters of the site.",
+			},
+			"megabytes_in": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The traffic received by the site, in megabytes.",
+			},
+			"megabytes_out": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The traffic sent by the site, in megabytes.",
+			},
+			"docker_socket_enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether newt on the site may read the Docker socket.",
+			},
 		},
 	}
 }
 
+func (d *siteDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("nice_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *siteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -69,19 +132,72 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	sites, err := d.client.ListSites(data.OrgID.ValueString())
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueString()
+
+	var site *client.Site
+	var err error
+	switch {
+	case !data.ID.IsNull():
+		site, err = d.client.GetSite(ctx, int(data.ID.ValueInt64()))
+	case !data.NiceID.IsNull():
+		site, err = d.client.GetSiteByNiceID(ctx, orgID, data.NiceID.ValueString())
+	default:
+		site, err = d.findSiteByName(ctx, orgID, data.Name.ValueString())
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error listing sites", err.Error())
+		resp.Diagnostics.AddError("Error reading site", err.Error())
+		return
+	}
+
+	// Sites are fetched by their global ID, which ignores the organization.
+	checkObjectOrg(&resp.Diagnostics, "Site", site.ID, site.OrgID, orgID)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	data.ID = types.Int64Value(int64(site.ID))
+	data.NiceID = types.StringValue(site.NiceID)
+	data.Name = types.StringValue(site.Name)
+	data.Type = types.StringValue(site.Type)
+	data.Subnet = types.StringPointerValue(site.Subnet)
+	data.Address = types.StringPointerValue(site.Address)
+	data.Online = types.BoolValue(site.Online)
+	data.LastBandwidthUpdate = types.StringPointerValue(site.LastBandwidthUpdate)
+	data.MegabytesIn = types.Float64Value(site.MegabytesIn)
+	data.MegabytesOut = types.Float64Value(site.MegabytesOut)
+	data.DockerSocketEnabled = types.BoolValue(site.DockerSocketEnabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findSiteByName resolves a site name to the full site, failing when the name
+// is missing or shared by several sites.
+func (d *siteDataSource) findSiteByName(ctx context.Context, orgID string, name string) (*client.Site, error) {
+	sites, err := d.client.ListSites(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	var id int
 	for _, s := range sites {
-		if s.Name == data.Name.ValueString() {
-			data.ID = types.Int64Value(int64(s.ID))
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-			return
+		if s.Name == name {
+			matches = append(matches, fmt.Sprintf("%d (%s)", s.ID, s.NiceID))
+			id = s.ID
 		}
 	}
 
-	resp.Diagnostics.AddError("Site not found", fmt.Sprintf("Could not find site with name %q in organization %q", data.Name.ValueString(), data.OrgID.ValueString()))
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("could not find site with name %q in organization %q", name, orgID)
+	case 1:
+		return d.client.GetSite(ctx, id)
+	default:
+		return nil, fmt.Errorf("%d sites are named %q in organization %q: %s; use id or nice_id instead", len(matches), name, orgID, strings.Join(matches, ", "))
+	}
 }
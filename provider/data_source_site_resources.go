@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &siteResourcesDataSource{}
+
+func NewSiteResourcesDataSource() datasource.DataSource {
+	return &siteResourcesDataSource{}
+}
+
+type siteResourcesDataSource struct {
+	client *client.Client
+}
+
+type siteResourcesDataSourceModel struct {
+	OrgID         types.String               `tfsdk:"org_id"`
+	SiteID        types.Int64                `tfsdk:"site_id"`
+	NameRegex     types.String               `tfsdk:"name_regex"`
+	Enabled       types.Bool                 `tfsdk:"enabled"`
+	IDs           []types.Int64              `tfsdk:"ids"`
+	SiteResources []siteResourceSummaryModel `tfsdk:"site_resources"`
+}
+
+type siteResourceSummaryModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	NiceID      types.String `tfsdk:"nice_id"`
+	Name        types.String `tfsdk:"name"`
+	Mode        types.String `tfsdk:"mode"`
+	SiteID      types.Int64  `tfsdk:"site_id"`
+	Destination types.String `tfsdk:"destination"`
+	Alias       types.String `tfsdk:"alias"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *siteResourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_resources"
+}
+
+func (d *siteResourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch the site resources of an organization or of a single site, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"site_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return site resources of this site.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return site resources whose name matches this regular expression.",
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return site resources that are (or are not) enabled.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the matching site resources.",
+			},
+			"site_resources": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching site resources.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the site resource.",
+						},
+						"nice_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The human readable ID of the site resource.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the site resource.",
+						},
+						"mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The mode of the site resource: `host` or `cidr`.",
+						},
+						"site_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the site providing access.",
+						},
+						"destination": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The destination host or CIDR.",
+						},
+						"alias": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The DNS alias of the site resource.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the site resource is enabled.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *siteResourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *siteResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data siteResourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newNameFilter(data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var siteResources []client.SiteResource
+	var err error
+	if data.SiteID.IsNull() {
+		siteResources, err = d.client.ListSiteResources(ctx, data.OrgID.ValueString())
+	} else {
+		siteResources, err = d.client.ListSiteResourcesBySite(ctx, data.OrgID.ValueString(), int(data.SiteID.ValueInt64()))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing site resources", err.Error())
+		return
+	}
+
+	data.IDs = []types.Int64{}
+	data.SiteResources = []siteResourceSummaryModel{}
+	for _, r := range siteResources {
+		if !filter.Match(r.Name) || !boolFilterMatches(data.Enabled, r.Enabled) {
+			continue
+		}
+		data.IDs = append(data.IDs, types.Int64Value(int64(r.ID)))
+		data.SiteResources = append(data.SiteResources, siteResourceSummaryModel{
+			ID:          types.Int64Value(int64(r.ID)),
+			NiceID:      types.StringValue(r.NiceID),
+			Name:        types.StringValue(r.Name),
+			Mode:        types.StringValue(r.Mode),
+			SiteID:      types.Int64Value(int64(r.SiteID)),
+			Destination: types.StringValue(r.Destination),
+			Alias:       types.StringPointerValue(r.Alias),
+			Enabled:     types.BoolValue(r.Enabled),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
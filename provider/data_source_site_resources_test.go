@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSiteResourcesDataSource_Filters(t *testing.T) {
+	const orgID = "site-resources-ds"
+	fake := testAccFake(t)
+	fake.AddOrg(orgID)
+	siteA := fake.AddSite(orgID, "site-a")
+	siteB := fake.AddSite(orgID, "site-b")
+
+	c := client.NewClient(testURL, testToken)
+	var ids []int
+	for _, res := range []*client.SiteResource{
+		{Name: "db-a", SiteID: siteA, Destination: "10.0.0.1", Enabled: true},
+		{Name: "cache-a", SiteID: siteA, Destination: "10.0.0.2"},
+		{Name: "db-b", SiteID: siteB, Destination: "10.0.0.3", Enabled: true},
+	} {
+		res.Mode = "host"
+		res.UserIDs, res.RoleIDs, res.ClientIDs = []string{}, []int{}, []int{}
+		created, err := c.CreateSiteResource(t.Context(), orgID, res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, created.ID)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteResourcesDataSourceConfig(orgID, siteA),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.all", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.all", "site_resources.#", "3"),
+
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.site_a", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.site_a", "ids.0", fmt.Sprint(ids[0])),
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.site_a", "ids.1", fmt.Sprint(ids[1])),
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.site_a", "site_resources.0.site_id", fmt.Sprint(siteA)),
+
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.databases", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.databases", "ids.0", fmt.Sprint(ids[0])),
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.databases", "ids.1", fmt.Sprint(ids[2])),
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.databases", "site_resources.1.site_id", fmt.Sprint(siteB)),
+
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.disabled", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.disabled", "site_resources.0.name", "cache-a"),
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.disabled", "site_resources.0.enabled", "false"),
+
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.enabled_site_a", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_site_resources.enabled_site_a", "ids.0", fmt.Sprint(ids[0])),
+				),
+			},
+		},
+	})
+}
+
+func testAccSiteResourcesDataSourceConfig(orgID string, siteID int) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_site_resources" "all" {
+  org_id = %[3]q
+}
+
+data "pangolin_site_resources" "site_a" {
+  org_id  = %[3]q
+  site_id = %[4]d
+}
+
+data "pangolin_site_resources" "databases" {
+  org_id     = %[3]q
+  name_regex = "^db-"
+}
+
+data "pangolin_site_resources" "disabled" {
+  org_id  = %[3]q
+  enabled = false
+}
+
+data "pangolin_site_resources" "enabled_site_a" {
+  org_id  = %[3]q
+  site_id = %[4]d
+  enabled = true
+}
+`, testURL, testToken, orgID, siteID)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSiteDataSource_Lookups(t *testing.T) {
+	fake := testAccFake(t)
+	siteID := fake.AddSite(testOrgID, "Lookup Site")
+
+	checks := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("data.pangolin_site.test", "id", fmt.Sprint(siteID)),
+		resource.TestCheckResourceAttr("data.pangolin_site.test", "name", "Lookup Site"),
+		resource.TestCheckResourceAttr("data.pangolin_site.test", "nice_id", fmt.Sprintf("lookup-site-%d", siteID)),
+		resource.TestCheckResourceAttr("data.pangolin_site.test", "type", "newt"),
+		resource.TestCheckResourceAttr("data.pangolin_site.test", "online", "true"),
+		resource.TestCheckNoResourceAttr("data.pangolin_site.test", "last_bandwidth_update"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteDataSourceConfig(testOrgID, fmt.Sprintf("id = %d", siteID)),
+				Check:  checks,
+			},
+			{
+				Config: testAccSiteDataSourceConfig(testOrgID, fmt.Sprintf("nice_id = \"lookup-site-%d\"", siteID)),
+				Check:  checks,
+			},
+			{
+				Config: testAccSiteDataSourceConfig(testOrgID, `name = "Lookup Site"`),
+				Check:  checks,
+			},
+		},
+	})
+}
+
+func TestAccSiteDataSource_OtherOrg(t *testing.T) {
+	fake := testAccFake(t)
+	fake.AddOrg("site-ds-other")
+	siteID := fake.AddSite("site-ds-other", "Elsewhere")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSiteDataSourceConfig(testOrgID, fmt.Sprintf("id = %d", siteID)),
+				ExpectError: regexp.MustCompile(`Site Not In Organization`),
+			},
+		},
+	})
+}
+
+func testAccSiteDataSourceConfig(orgID string, lookup string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_site" "test" {
+  org_id = %[3]q
+  %[4]s
+}
+`, testURL, testToken, orgID, lookup)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &sitesDataSource{}
+
+func NewSitesDataSource() datasource.DataSource {
+	return &sitesDataSource{}
+}
+
+type sitesDataSource struct {
+	client *client.Client
+}
+
+type sitesDataSourceModel struct {
+	OrgID     types.String       `tfsdk:"org_id"`
+	NameRegex types.String       `tfsdk:"name_regex"`
+	Online    types.Bool         `tfsdk:"online"`
+	IDs       []types.Int64      `tfsdk:"ids"`
+	Sites     []siteSummaryModel `tfsdk:"sites"`
+}
+
+type siteSummaryModel struct {
+	ID     types.Int64  `tfsdk:"id"`
+	NiceID types.String `tfsdk:"nice_id"`
+	Name   types.String `tfsdk:"name"`
+	Type   types.String `tfsdk:"type"`
+	Online types.Bool   `tfsdk:"online"`
+}
+
+func (d *sitesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sites"
+}
+
+func (d *sitesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch all sites of an organization, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return sites whose name matches this regular expression.",
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"online": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return sites that are (or are not) online.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the matching sites.",
+			},
+			"sites": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching sites.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the site.",
+						},
+						"nice_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The human readable ID of the site.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the site.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the site, e.g. `newt` or `wireguard`.",
+						},
+						"online": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the site is connected.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sitesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sitesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newNameFilter(data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sites, err := d.client.ListSites(ctx, data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing sites", err.Error())
+		return
+	}
+
+	data.IDs = []types.Int64{}
+	data.Sites = []siteSummaryModel{}
+	for _, s := range sites {
+		if !filter.Match(s.Name) || !boolFilterMatches(data.Online, s.Online) {
+			continue
+		}
+		data.IDs = append(data.IDs, types.Int64Value(int64(s.ID)))
+		data.Sites = append(data.Sites, siteSummaryModel{
+			ID:     types.Int64Value(int64(s.ID)),
+			NiceID: types.StringValue(s.NiceID),
+			Name:   types.StringValue(s.Name),
+			Type:   types.StringValue(s.Type),
+			Online: types.BoolValue(s.Online),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
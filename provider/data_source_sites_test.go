@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSitesDataSource_Filters(t *testing.T) {
+	const orgID = "sites-ds"
+	fake := testAccFake(t)
+	fake.AddOrg(orgID)
+	web1 := fake.AddSite(orgID, "web-1")
+	web2 := fake.AddSite(orgID, "web-2")
+	// Sites created through the API stay offline until their newt connects.
+	db, err := client.NewClient(testURL, testToken).CreateSite(t.Context(), orgID, "db-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSitesDataSourceConfig(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pangolin_sites.all", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_sites.all", "sites.#", "3"),
+
+					resource.TestCheckResourceAttr("data.pangolin_sites.web", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.pangolin_sites.web", "ids.0", fmt.Sprint(web1)),
+					resource.TestCheckResourceAttr("data.pangolin_sites.web", "ids.1", fmt.Sprint(web2)),
+					resource.TestCheckResourceAttr("data.pangolin_sites.web", "sites.0.name", "web-1"),
+					resource.TestCheckResourceAttr("data.pangolin_sites.web", "sites.0.online", "true"),
+
+					resource.TestCheckResourceAttr("data.pangolin_sites.offline", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_sites.offline", "sites.0.id", fmt.Sprint(db.ID)),
+					resource.TestCheckResourceAttr("data.pangolin_sites.offline", "sites.0.name", "db-1"),
+					resource.TestCheckResourceAttr("data.pangolin_sites.offline", "sites.0.online", "false"),
+
+					resource.TestCheckResourceAttr("data.pangolin_sites.none", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSitesDataSourceConfig(orgID string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_sites" "all" {
+  org_id = %[3]q
+}
+
+data "pangolin_sites" "web" {
+  org_id     = %[3]q
+  name_regex = "^web-"
+}
+
+data "pangolin_sites" "offline" {
+  org_id = %[3]q
+  online = false
+}
+
+data "pangolin_sites" "none" {
+  org_id     = %[3]q
+  name_regex = "^web-"
+  online     = false
+}
+`, testURL, testToken, orgID)
+}
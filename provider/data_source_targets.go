@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &targetsDataSource{}
+
+func NewTargetsDataSource() datasource.DataSource {
+	return &targetsDataSource{}
+}
+
+type targetsDataSource struct {
+	client *client.Client
+}
+
+type targetsDataSourceModel struct {
+	ResourceID types.Int64          `tfsdk:"resource_id"`
+	SiteID     types.Int64          `tfsdk:"site_id"`
+	Enabled    types.Bool           `tfsdk:"enabled"`
+	IDs        []types.Int64        `tfsdk:"ids"`
+	Targets    []targetSummaryModel `tfsdk:"targets"`
+}
+
+type targetSummaryModel struct {
+	ID      types.Int64  `tfsdk:"id"`
+	SiteID  types.Int64  `tfsdk:"site_id"`
+	IP      types.String `tfsdk:"ip"`
+	Port    types.Int64  `tfsdk:"port"`
+	Method  types.String `tfsdk:"method"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *targetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_targets"
+}
+
+func (d *targetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch the targets of a resource, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"resource_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the resource.",
+			},
+			"site_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return targets reached through this site.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return targets that are (or are not) enabled.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the matching targets.",
+			},
+			"targets": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching targets.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the target.",
+						},
+						"site_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the site the target is reached through.",
+						},
+						"ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The IP or hostname of the target.",
+						},
+						"port": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The port of the target.",
+						},
+						"method": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The scheme used to reach the target.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the target is enabled.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *targetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *targetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data targetsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, err := d.client.ListTargets(ctx, int(data.ResourceID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing targets", err.Error())
+		return
+	}
+
+	data.IDs = []types.Int64{}
+	data.Targets = []targetSummaryModel{}
+	for _, t := range targets {
+		if !data.SiteID.IsNull() && int64(t.SiteID) != data.SiteID.ValueInt64() {
+			continue
+		}
+		if !boolFilterMatches(data.Enabled, t.Enabled) {
+			continue
+		}
+		data.IDs = append(data.IDs, types.Int64Value(int64(t.ID)))
+		data.Targets = append(data.Targets, targetSummaryModel{
+			ID:      types.Int64Value(int64(t.ID)),
+			SiteID:  types.Int64Value(int64(t.SiteID)),
+			IP:      types.StringValue(t.IP),
+			Port:    types.Int64Value(int64(t.Port)),
+			Method:  types.StringPointerValue(t.Method),
+			Enabled: types.BoolValue(t.Enabled),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
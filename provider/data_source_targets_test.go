@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTargetsDataSource_Filters(t *testing.T) {
+	const orgID = "targets-ds"
+	fake := testAccFake(t)
+	fake.AddOrg(orgID)
+	fake.AddDomain(orgID, orgID, orgID+".localhost")
+	siteA := fake.AddSite(orgID, "site-a")
+	siteB := fake.AddSite(orgID, "site-b")
+
+	c := client.NewClient(testURL, testToken)
+	res, err := c.CreateResource(t.Context(), orgID, &client.Resource{Name: "app", Protocol: "tcp", Http: true, Subdomain: "app", DomainID: orgID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int
+	for _, target := range []*client.Target{
+		{SiteID: siteA, IP: "10.0.0.1", Port: 80, Enabled: true},
+		{SiteID: siteA, IP: "10.0.0.2", Port: 80},
+		{SiteID: siteB, IP: "10.0.0.3", Port: 8080, Enabled: true},
+	} {
+		created, err := c.CreateTarget(t.Context(), res.ID, target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, created.ID)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetsDataSourceConfig(res.ID, siteA),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pangolin_targets.all", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_targets.all", "targets.#", "3"),
+					resource.TestCheckResourceAttr("data.pangolin_targets.all", "targets.2.ip", "10.0.0.3"),
+					resource.TestCheckResourceAttr("data.pangolin_targets.all", "targets.2.port", "8080"),
+					resource.TestCheckResourceAttr("data.pangolin_targets.all", "targets.2.site_id", fmt.Sprint(siteB)),
+
+					resource.TestCheckResourceAttr("data.pangolin_targets.site_a", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.pangolin_targets.site_a", "ids.0", fmt.Sprint(ids[0])),
+					resource.TestCheckResourceAttr("data.pangolin_targets.site_a", "ids.1", fmt.Sprint(ids[1])),
+
+					resource.TestCheckResourceAttr("data.pangolin_targets.disabled", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_targets.disabled", "ids.0", fmt.Sprint(ids[1])),
+					resource.TestCheckResourceAttr("data.pangolin_targets.disabled", "targets.0.enabled", "false"),
+
+					resource.TestCheckResourceAttr("data.pangolin_targets.enabled_site_a", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_targets.enabled_site_a", "ids.0", fmt.Sprint(ids[0])),
+				),
+			},
+		},
+	})
+}
+
+func testAccTargetsDataSourceConfig(resourceID int, siteID int) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_targets" "all" {
+  resource_id = %[3]d
+}
+
+data "pangolin_targets" "site_a" {
+  resource_id = %[3]d
+  site_id     = %[4]d
+}
+
+data "pangolin_targets" "disabled" {
+  resource_id = %[3]d
+  enabled     = false
+}
+
+data "pangolin_targets" "enabled_site_a" {
+  resource_id = %[3]d
+  site_id     = %[4]d
+  enabled     = true
+}
+`, testURL, testToken, resourceID, siteID)
+}
@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &userAccessDataSource{}
+
+// How a user reaches a resource, as reported in the via attribute.
+const (
+	accessViaAdmin      = "admin"
+	accessViaPublic     = "public"
+	accessViaUser       = "user"
+	accessViaRole       = "role"
+	accessViaPassword   = "password"
+	accessViaPincode    = "pincode"
+	accessViaWhitelist  = "whitelist"
+	accessViaHeaderAuth = "header_auth"
+)
+
+func NewUserAccessDataSource() datasource.DataSource {
+	return &userAccessDataSource{}
+}
+
+type userAccessDataSource struct {
+	client *client.Client
+}
+
+type userAccessDataSourceModel struct {
+	OrgID           types.String                  `tfsdk:"org_id"`
+	UserID          types.String                  `tfsdk:"user_id"`
+	HasOrgAccess    types.Bool                    `tfsdk:"has_org_access"`
+	RoleID          types.Int64                   `tfsdk:"role_id"`
+	IsAdmin         types.Bool                    `tfsdk:"is_admin"`
+	ResourceIDs     []types.Int64                 `tfsdk:"resource_ids"`
+	SiteResourceIDs []types.Int64                 `tfsdk:"site_resource_ids"`
+	Resources       []userResourceAccessModel     `tfsdk:"resources"`
+	SiteResources   []userSiteResourceAccessModel `tfsdk:"site_resources"`
+}
+
+type userResourceAccessModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	FullDomain types.String `tfsdk:"full_domain"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Via        types.String `tfsdk:"via"`
+}
+
+type userSiteResourceAccessModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Mode        types.String `tfsdk:"mode"`
+	Destination types.String `tfsdk:"destination"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Via         types.String `tfsdk:"via"`
+}
+
+func (d *userAccessDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_access"
+}
+
+func (d *userAccessDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	viaDescription := "How the user reaches it: `admin` (the user holds the admin role), `public` (no authentication required), `user` (granted directly), `role` (granted to the user's role), " +
+		"or, for resources without SSO, the shared credential that guards them: `password`, `pincode`, `whitelist` (an emailed one-time code) or `header_auth`."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports which resources and site resources a user can reach in an organization, " +
+			"combining direct grants, the user's role, and resources that require no authentication or only a shared credential. " +
+			"This reads the access list of every resource, so it makes two API calls per resource.",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user.",
+			},
+			"has_org_access": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user may access the organization at all, taking organization policies such as required two-factor authentication into account.",
+			},
+			"role_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user's role.",
+			},
+			"is_admin": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user is an owner or holds the admin role.",
+			},
+			"resource_ids": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the resources the user can reach.",
+			},
+			"site_resource_ids": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the site resources the user can reach.",
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resources the user can reach.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the resource.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the resource.",
+						},
+						"full_domain": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The full domain of an HTTP resource.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the resource is enabled.",
+						},
+						"via": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: viaDescription,
+						},
+					},
+				},
+			},
+			"site_resources": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The site resources the user can reach.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the site resource.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the site resource.",
+						},
+						"mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The mode of the site resource: `host` or `cidr`.",
+						},
+						"destination": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The destination host or CIDR.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the site resource is enabled.",
+						},
+						"via": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: viaDescription,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *userAccessDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *userAccessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userAccessDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = orgIDOrDefault(d.client, data.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueString()
+	userID := data.UserID.ValueString()
+
+	user, err := d.client.GetOrgUser(ctx, orgID, userID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization user", err.Error())
+		return
+	}
+
+	hasAccess, err := d.client.CheckOrgUserAccess(ctx, orgID, userID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error checking organization access", err.Error())
+		return
+	}
+
+	roles, err := d.client.ListRoles(ctx, orgID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing roles", err.Error())
+		return
+	}
+
+	isAdmin := user.IsOwner
+	for _, r := range roles {
+		if r.ID == user.RoleID && r.IsAdmin {
+			isAdmin = true
+		}
+	}
+
+	data.HasOrgAccess = types.BoolValue(hasAccess)
+	data.RoleID = types.Int64Value(int64(user.RoleID))
+	data.IsAdmin = types.BoolValue(isAdmin)
+
+	resources, err := d.client.ListResources(ctx, orgID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing resources", err.Error())
+		return
+	}
+
+	data.ResourceIDs = []types.Int64{}
+	data.Resources = []userResourceAccessModel{}
+	for _, r := range resources {
+		var via string
+		switch {
+		case isAdmin:
+			via = accessViaAdmin
+		case !r.Http:
+			via = accessViaPublic
+		case r.SSO != nil && !*r.SSO:
+			via = nonSSOAccessVia(r)
+		default:
+			users, err := d.client.GetResourceUsers(ctx, r.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading resource users", err.Error())
+				return
+			}
+			roleIDs, err := d.client.GetResourceRoles(ctx, r.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading resource roles", err.Error())
+				return
+			}
+			via = accessVia(userID, user.RoleID, users, roleIDs)
+		}
+		if via == "" {
+			continue
+		}
+
+		data.ResourceIDs = append(data.ResourceIDs, types.Int64Value(int64(r.ID)))
+		data.Resources = append(data.Resources, userResourceAccessModel{
+			ID:         types.Int64Value(int64(r.ID)),
+			Name:       types.StringValue(r.Name),
+			FullDomain: types.StringValue(r.FullDomain),
+			Enabled:    types.BoolValue(r.Enabled == nil || *r.Enabled),
+			Via:        types.StringValue(via),
+		})
+	}
+
+	siteResources, err := d.client.ListSiteResources(ctx, orgID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing site resources", err.Error())
+		return
+	}
+
+	data.SiteResourceIDs = []types.Int64{}
+	data.SiteResources = []userSiteResourceAccessModel{}
+	for _, r := range siteResources {
+		via := accessViaAdmin
+		if !isAdmin {
+			users, err := d.client.GetSiteResourceUsers(ctx, r.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading site resource users", err.Error())
+				return
+			}
+			roleIDs, err := d.client.GetSiteResourceRoles(ctx, r.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading site resource roles", err.Error())
+				return
+			}
+			via = accessVia(userID, user.RoleID, users, roleIDs)
+		}
+		if via == "" {
+			continue
+		}
+
+		data.SiteResourceIDs = append(data.SiteResourceIDs, types.Int64Value(int64(r.ID)))
+		data.SiteResources = append(data.SiteResources, userSiteResourceAccessModel{
+			ID:          types.Int64Value(int64(r.ID)),
+			Name:        types.StringValue(r.Name),
+			Mode:        types.StringValue(r.Mode),
+			Destination: types.StringValue(r.Destination),
+			Enabled:     types.BoolValue(r.Enabled),
+			Via:         types.StringValue(via),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// nonSSOAccessVia reports what guards an HTTP resource without SSO: the first
+// of its password, PIN code, email whitelist and header authentication, or
+// nothing at all.
+func nonSSOAccessVia(r client.Resource) string {
+	switch {
+	case r.PasswordID != nil:
+		return accessViaPassword
+	case r.PincodeID != nil:
+		return accessViaPincode
+	case r.Whitelist:
+		return accessViaWhitelist
+	case r.HeaderAuthID != nil:
+		return accessViaHeaderAuth
+	default:
+		return accessViaPublic
+	}
+}
+
+// accessVia reports how a user reaches an object given the users and roles
+// granted access to it, or "" when the user cannot reach it. Direct grants win
+// over role grants.
+func accessVia(userID string, roleID int, userIDs []string, roleIDs []int) string {
+	for _, id := range userIDs {
+		if id == userID {
+			return accessViaUser
+		}
+	}
+	for _, id := range roleIDs {
+		if id == roleID {
+			return accessViaRole
+		}
+	}
+	return ""
+}
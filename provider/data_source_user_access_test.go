@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+)
+
+func TestAccessVia(t *testing.T) {
+	cases := []struct {
+		name    string
+		userIDs []string
+		roleIDs []int
+		want    string
+	}{
+		{name: "direct grant", userIDs: []string{"u1"}, roleIDs: []int{3}, want: accessViaUser},
+		{name: "role grant", userIDs: []string{"other"}, roleIDs: []int{2, 3}, want: accessViaRole},
+		{name: "no grant", userIDs: []string{"other"}, roleIDs: []int{4}, want: ""},
+		{name: "empty", want: ""},
+	}
+
+	for _, tc := range cases {
+		if got := accessVia("u1", 3, tc.userIDs, tc.roleIDs); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestNonSSOAccessVia(t *testing.T) {
+	id := 1
+	cases := []struct {
+		name string
+		res  client.Resource
+		want string
+	}{
+		{name: "unprotected", want: accessViaPublic},
+		{name: "password", res: client.Resource{PasswordID: &id, Whitelist: true}, want: accessViaPassword},
+		{name: "pincode", res: client.Resource{PincodeID: &id}, want: accessViaPincode},
+		{name: "whitelist", res: client.Resource{Whitelist: true}, want: accessViaWhitelist},
+		{name: "header auth", res: client.Resource{HeaderAuthID: &id}, want: accessViaHeaderAuth},
+	}
+
+	for _, tc := range cases {
+		if got := nonSSOAccessVia(tc.res); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
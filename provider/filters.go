@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nameFilter matches names against an optional name_regex attribute.
+type nameFilter struct {
+	re *regexp.Regexp
+}
+
+func newNameFilter(nameRegex types.String) (nameFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if nameRegex.IsNull() || nameRegex.ValueString() == "" {
+		return nameFilter{}, diags
+	}
+
+	re, err := regexp.Compile(nameRegex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+		return nameFilter{}, diags
+	}
+	return nameFilter{re: re}, diags
+}
+
+func (f nameFilter) Match(name string) bool {
+	return f.re == nil || f.re.MatchString(name)
+}
+
+// boolFilterMatches reports whether v satisfies an optional boolean filter.
+func boolFilterMatches(filter types.Bool, v bool) bool {
+	return filter.IsNull() || filter.ValueBool() == v
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNameFilter(t *testing.T) {
+	f, diags := newNameFilter(types.StringValue("^prod-"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !f.Match("prod-web") || f.Match("staging-web") {
+		t.Error("name_regex did not filter as expected")
+	}
+
+	f, _ = newNameFilter(types.StringNull())
+	if !f.Match("anything") {
+		t.Error("an unset name_regex must match every name")
+	}
+
+	if _, diags := newNameFilter(types.StringValue("prod-[")); !diags.HasError() {
+		t.Error("expected an error for an invalid regular expression")
+	}
+}
+
+func TestBoolFilterMatches(t *testing.T) {
+	if !boolFilterMatches(types.BoolNull(), false) {
+		t.Error("an unset filter must match")
+	}
+	if !boolFilterMatches(types.BoolValue(true), true) || boolFilterMatches(types.BoolValue(true), false) {
+		t.Error("a set filter must match only equal values")
+	}
+}
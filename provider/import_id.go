@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Prefixes selecting how the second part of an org_id/<ref> import
+// identifier is resolved. A bare ref is a numeric ID.
+const (
+	importPrefixNice = "nice:"
+	importPrefixHost = "host:"
+)
+
+// orgImportID is a parsed org_id/<ref> import identifier. Exactly one of ID,
+// NiceID or Host is set.
+type orgImportID struct {
+	OrgID  string
+	ID     int64
+	NiceID string
+	Host   string
+
+	// SiteID scopes NiceID to a site; only set by parseSiteImportID.
+	SiteID int64
+}
+
+// parseOrgImportID parses org_id/id, org_id/nice:<niceId> and
+// org_id/host:<fqdn> import identifiers.
+func parseOrgImportID(raw string) (orgImportID, error) {
+	format := fmt.Errorf("expected import identifier with format: org_id/id, org_id/nice:<nice_id> or org_id/host:<fqdn>. Got: %q", raw)
+
+	orgID, ref, ok := strings.Cut(raw, "/")
+	if !ok || orgID == "" || ref == "" {
+		return orgImportID{}, format
+	}
+
+	id := orgImportID{OrgID: orgID}
+	switch {
+	case strings.HasPrefix(ref, importPrefixNice):
+		id.NiceID = strings.TrimPrefix(ref, importPrefixNice)
+		if id.NiceID == "" {
+			return orgImportID{}, format
+		}
+	case strings.HasPrefix(ref, importPrefixHost):
+		id.Host = strings.TrimSuffix(strings.TrimPrefix(ref, importPrefixHost), ".")
+		if id.Host == "" {
+			return orgImportID{}, format
+		}
+	default:
+		n, err := strconv.ParseInt(ref, 10, 64)
+		if err != nil {
+			return orgImportID{}, fmt.Errorf("expected id to be an integer. Got: %q", ref)
+		}
+		id.ID = n
+	}
+	return id, nil
+}
+
+// parseSiteImportID additionally accepts org_id/site_id/nice:<niceId> for
+// objects whose nice ID is only unique within a site.
+func parseSiteImportID(raw string) (orgImportID, error) {
+	parts := strings.SplitN(raw, "/", 3)
+	if len(parts) < 3 {
+		return parseOrgImportID(raw)
+	}
+
+	format := fmt.Errorf("expected import identifier with format: org_id/id, org_id/nice:<nice_id>, org_id/site_id/nice:<nice_id> or org_id/host:<fqdn>. Got: %q", raw)
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || !strings.HasPrefix(parts[2], importPrefixNice) {
+		return orgImportID{}, format
+	}
+	id, err := parseOrgImportID(parts[0] + "/" + parts[2])
+	if err != nil {
+		return orgImportID{}, format
+	}
+	id.SiteID = siteID
+	return id, nil
+}
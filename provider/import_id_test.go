@@ -0,0 +1,58 @@
+package provider
+
+import "testing"
+
+func TestParseOrgImportID(t *testing.T) {
+	cases := []struct {
+		raw  string
+		want orgImportID
+	}{
+		{"org/42", orgImportID{OrgID: "org", ID: 42}},
+		{"org/nice:quiet-fox", orgImportID{OrgID: "org", NiceID: "quiet-fox"}},
+		{"org/host:app.example.com.", orgImportID{OrgID: "org", Host: "app.example.com"}},
+	}
+	for _, c := range cases {
+		got, err := parseOrgImportID(c.raw)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", c.raw, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%q: got %+v, want %+v", c.raw, got, c.want)
+		}
+	}
+
+	for _, raw := range []string{"", "org", "/42", "org/", "org/abc", "org/nice:", "org/host:"} {
+		if _, err := parseOrgImportID(raw); err == nil {
+			t.Errorf("%q: expected an error", raw)
+		}
+	}
+}
+
+func TestParseSiteImportID(t *testing.T) {
+	cases := []struct {
+		raw  string
+		want orgImportID
+	}{
+		{"org/42", orgImportID{OrgID: "org", ID: 42}},
+		{"org/nice:quiet-fox", orgImportID{OrgID: "org", NiceID: "quiet-fox"}},
+		{"org/7/nice:quiet-fox", orgImportID{OrgID: "org", SiteID: 7, NiceID: "quiet-fox"}},
+		{"org/host:db.internal", orgImportID{OrgID: "org", Host: "db.internal"}},
+	}
+	for _, c := range cases {
+		got, err := parseSiteImportID(c.raw)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", c.raw, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%q: got %+v, want %+v", c.raw, got, c.want)
+		}
+	}
+
+	for _, raw := range []string{"org/7/42", "org/x/nice:quiet-fox", "org/7/nice:", "org/7/host:db.internal", "/7/nice:quiet-fox"} {
+		if _, err := parseSiteImportID(raw); err == nil {
+			t.Errorf("%q: expected an error", raw)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// missingOrgID reports that neither the configuration nor the provider names
+// an organization.
+func missingOrgID(diags *diag.Diagnostics) {
+	diags.AddAttributeError(
+		path.Root("org_id"),
+		"Missing Organization",
+		"Set org_id here, or a default organization with the provider's org_id attribute, the PANGOLIN_ORG_ID environment variable or the org_id of the credentials profile.",
+	)
+}
+
+// orgIDOrDefault returns orgID, or the provider's default organization when
+// the data source does not set one.
+func orgIDOrDefault(c *client.Client, orgID types.String, diags *diag.Diagnostics) types.String {
+	if !orgID.IsNull() {
+		return orgID
+	}
+	if c == nil || c.OrgID == "" {
+		missingOrgID(diags)
+		return orgID
+	}
+	return types.StringValue(c.OrgID)
+}
+
+// checkObjectOrg reports an error when an object looked up by its global ID
+// belongs to another organization than the one the data source asked for.
+func checkObjectOrg(diags *diag.Diagnostics, kind string, id any, objectOrgID string, orgID string) {
+	if objectOrgID == orgID {
+		return
+	}
+	diags.AddAttributeError(
+		path.Root("id"),
+		fmt.Sprintf("%s Not In Organization", kind),
+		fmt.Sprintf("%s %v belongs to organization %q, not %q.", kind, id, objectOrgID, orgID),
+	)
+}
+
+// planDefaultOrgID plans the provider's default organization for a resource
+// that does not set org_id, and replaces the resource when the default moved
+// it to another organization. It is called from ModifyPlan.
+func planDefaultOrgID(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || c == nil {
+		return
+	}
+
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("org_id"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+	if c.OrgID == "" {
+		missingOrgID(&resp.Diagnostics)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("org_id"), c.OrgID)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var current types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("org_id"), &current)...)
+	if current.ValueString() != c.OrgID {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("org_id"))
+	}
+}
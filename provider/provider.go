@@ -2,13 +2,27 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/groteck/terraform-provider-pangolin/internal/credentials"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,11 +36,30 @@ func New(version string) func() provider.Provider {
 
 type pangolinProvider struct {
 	version string
+
+	// transport replaces the HTTP transport of the client; tests use it to
+	// record and replay API traffic.
+	transport http.RoundTripper
 }
 
 type pangolinProviderModel struct {
-	BaseURL types.String `tfsdk:"base_url"`
-	Token   types.String `tfsdk:"token"`
+	BaseURL            types.String  `tfsdk:"base_url"`
+	Token              types.String  `tfsdk:"token"`
+	TokenFile          types.String  `tfsdk:"token_file"`
+	Profile            types.String  `tfsdk:"profile"`
+	OrgID              types.String  `tfsdk:"org_id"`
+	CACertPEM          types.String  `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String  `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool    `tfsdk:"insecure_skip_verify"`
+	ClientCert         types.String  `tfsdk:"client_cert"`
+	ClientKey          types.String  `tfsdk:"client_key"`
+	ProxyURL           types.String  `tfsdk:"proxy_url"`
+	Headers            types.Map     `tfsdk:"headers"`
+	RequestTimeout     types.String  `tfsdk:"request_timeout"`
+	MaxRequestsPerSec  types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrent      types.Int64   `tfsdk:"max_concurrent_requests"`
+	SkipConnCheck      types.Bool    `tfsdk:"skip_connection_check"`
+	RequiredActions    types.Set     `tfsdk:"required_actions"`
 }
 
 func (p *pangolinProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -36,15 +69,110 @@ func (p *pangolinProvider) Metadata(_ context.Context, _ provider.MetadataReques
 
 func (p *pangolinProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Each connection setting is taken from the first source that sets it: the provider attribute, then the environment variable, then the selected profile of the credentials file. " +
+			"For the token, `token_file` comes right after `token`. " +
+			"The credentials file is ~/.config/pangolin/credentials, or $XDG_CONFIG_HOME/pangolin/credentials, unless PANGOLIN_CREDENTIALS_FILE names another. " +
+			"It holds INI-style [profile] sections with base_url, token and org_id keys.",
 		Attributes: map[string]schema.Attribute{
 			"base_url": schema.StringAttribute{
 				Optional:    true,
-				Description: "Pangolin API base URL. Can also be set via the PANGOLIN_BASE_URL environment variable. Defaults to https://api.pangolin.net/v1",
+				Description: "Pangolin API base URL. Falls back to the PANGOLIN_BASE_URL environment variable, then the profile's base_url. Defaults to https://api.pangolin.net/v1",
 			},
 			"token": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Pangolin API token. Falls back to `token_file`, the PANGOLIN_TOKEN environment variable, then the profile's token. Conflicts with `token_file`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token_file")),
+				},
+			},
+			"token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file holding the Pangolin API token, e.g. a mounted secret. Surrounding whitespace is ignored. Conflicts with `token`.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Profile of the credentials file to read settings from. Falls back to the PANGOLIN_PROFILE environment variable. Defaults to `default`, which may be absent; a profile selected explicitly must exist.",
+			},
+			"org_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Default organization for resources and data sources that do not set `org_id`. Falls back to the PANGOLIN_ORG_ID environment variable, then the profile's org_id.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded CA certificates to trust in addition to the system roots, e.g. for a self-hosted Pangolin behind a private CA. Conflicts with `ca_cert_file`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file holding PEM encoded CA certificates to trust in addition to the system roots. Conflicts with `ca_cert_pem`.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip verification of the API server's TLS certificate. Only use this against test instances. Defaults to `false`.",
+			},
+			"client_cert": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded client certificate for mutual TLS, e.g. `file(\"client.pem\")`. Requires `client_key`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of `client_cert`. Requires `client_cert`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of an HTTP proxy to send API requests through. Defaults to the proxy set by the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.",
+				Validators: []validator.String{
+					httpURLValidator{},
+				},
+			},
+			"headers": schema.MapAttribute{
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Pangolin API token. Can also be set via the PANGOLIN_TOKEN environment variable.",
+				ElementType: types.StringType,
+				Description: "Extra HTTP headers to send with every API request, e.g. for an authenticating reverse proxy in front of Pangolin. They cannot replace the `Authorization` and `Content-Type` headers the provider sets.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for each API request, as a duration like `30s` or `2m`. A resource's `timeouts` block replaces it for that resource's operations. Defaults to `30s`.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Limit the rate of API requests across all resources and data sources, e.g. to protect a small Pangolin instance under a high `-parallelism`. Requests beyond the limit wait, in bursts of up to one second's worth. Defaults to no limit.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Limit the number of API requests in flight at once across all resources and data sources. Defaults to no limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"skip_connection_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip the check of the API and the token when the provider is configured, e.g. for offline `terraform validate` runs. Can also be set via the PANGOLIN_SKIP_CONNECTION_CHECK environment variable. Defaults to `false`.",
+			},
+			"required_actions": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Actions the token must be granted in the default organization, e.g. `[\"createRole\", \"deleteRole\"]`. The connection check fails when the API key lacks any of them. It is skipped for tokens that are not organization API keys, and without a default `org_id`.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(client.ActionIDs...)),
+				},
 			},
 		},
 	}
@@ -59,36 +187,180 @@ func (p *pangolinProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	baseURL := os.Getenv("PANGOLIN_BASE_URL")
-	if baseURL == "" {
-		baseURL = "https://api.pangolin.net/v1"
-	}
-	if !data.BaseURL.IsNull() {
-		baseURL = data.BaseURL.ValueString()
+	conn, diags := resolveConnection(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	token := os.Getenv("PANGOLIN_TOKEN")
-	if !data.Token.IsNull() {
-		token = data.Token.ValueString()
+	transportConfig, diags := newTransportConfig(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	if token == "" {
-		resp.Diagnostics.AddError("Missing API Token", "Pangolin API token must be provided via the 'token' attribute or PANGOLIN_TOKEN environment variable.")
+	transport, err := client.NewTransport(transportConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Connection Settings", err.Error())
 		return
 	}
 
-	c := client.NewClient(baseURL, token)
+	c := client.NewClient(conn.baseURL, conn.token)
+	c.OrgID = conn.orgID
+	if v := data.RequestTimeout.ValueString(); v != "" {
+		// The durationValidator already rejected invalid values.
+		c.RequestTimeout, _ = time.ParseDuration(v)
+	}
+	if rate, concurrent := data.MaxRequestsPerSec.ValueFloat64(), data.MaxConcurrent.ValueInt64(); rate > 0 || concurrent > 0 {
+		c.Limiter = client.NewLimiter(rate, int(concurrent))
+	}
+	c.HTTPClient.Transport = transport
+	if p.transport != nil {
+		c.HTTPClient.Transport = p.transport
+	}
+
+	if !skipConnectionCheck(data) {
+		resp.Diagnostics.Append(checkConnection(ctx, c, data.RequiredActions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	resp.DataSourceData = c
 	resp.ResourceData = c
 }
 
+// connection is the API endpoint, token and default organization the provider
+// uses once every source of settings is considered.
+type connection struct {
+	baseURL string
+	token   string
+	orgID   string
+}
+
+// resolveConnection picks each setting from the provider configuration, then
+// the environment, then the selected profile of the credentials file.
+func resolveConnection(data pangolinProviderModel) (connection, diag.Diagnostics) {
+	profile, diags := loadProfile(data.Profile)
+	if diags.HasError() {
+		return connection{}, diags
+	}
+
+	conn := connection{
+		baseURL: firstNonEmpty(data.BaseURL.ValueString(), os.Getenv("PANGOLIN_BASE_URL"), profile.BaseURL, "https://api.pangolin.net/v1"),
+		orgID:   firstNonEmpty(data.OrgID.ValueString(), os.Getenv("PANGOLIN_ORG_ID"), profile.OrgID),
+	}
+
+	tokenFromFile := ""
+	if file := data.TokenFile.ValueString(); file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			diags.AddAttributeError(path.Root("token_file"), "Unreadable Token File", err.Error())
+			return conn, diags
+		}
+		tokenFromFile = strings.TrimSpace(string(content))
+		if tokenFromFile == "" {
+			diags.AddAttributeError(path.Root("token_file"), "Empty Token File", fmt.Sprintf("%s does not contain a token.", file))
+			return conn, diags
+		}
+	}
+	conn.token = firstNonEmpty(data.Token.ValueString(), tokenFromFile, os.Getenv("PANGOLIN_TOKEN"), profile.Token)
+
+	if conn.token == "" {
+		diags.AddError("Missing API Token", "Pangolin API token must be provided via the 'token' or 'token_file' attribute, the PANGOLIN_TOKEN environment variable, or a profile of the credentials file.")
+	}
+	return conn, diags
+}
+
+// loadProfile reads the selected profile of the credentials file. The
+// implicit default profile may be missing, along with the whole file; a
+// profile chosen through the profile attribute or PANGOLIN_PROFILE must exist.
+func loadProfile(attr types.String) (credentials.Profile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := firstNonEmpty(attr.ValueString(), os.Getenv("PANGOLIN_PROFILE"))
+	explicit := name != ""
+	if !explicit {
+		name = credentials.DefaultProfile
+	}
+
+	file := os.Getenv("PANGOLIN_CREDENTIALS_FILE")
+	if file == "" {
+		var err error
+		if file, err = credentials.DefaultPath(); err != nil {
+			if explicit {
+				diags.AddAttributeError(path.Root("profile"), "Credentials File Not Found", err.Error())
+			}
+			return credentials.Profile{}, diags
+		}
+	}
+
+	profiles, err := credentials.Load(file)
+	switch {
+	case errors.Is(err, fs.ErrNotExist) && !explicit:
+		return credentials.Profile{}, diags
+	case errors.Is(err, fs.ErrNotExist):
+		diags.AddAttributeError(path.Root("profile"), "Credentials File Not Found", fmt.Sprintf("Profile %q was selected, but %s does not exist.", name, file))
+		return credentials.Profile{}, diags
+	case err != nil:
+		diags.AddAttributeError(path.Root("profile"), "Invalid Credentials File", err.Error())
+		return credentials.Profile{}, diags
+	}
+
+	profile, ok := profiles[name]
+	if !ok && explicit {
+		diags.AddAttributeError(path.Root("profile"), "Unknown Profile", fmt.Sprintf("The credentials file %s has no profile %q.", file, name))
+	}
+	return profile, diags
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// newTransportConfig collects the TLS, proxy and header settings of the
+// provider configuration.
+func newTransportConfig(ctx context.Context, data pangolinProviderModel) (client.TransportConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	cfg := client.TransportConfig{
+		CACertPEM:          []byte(data.CACertPEM.ValueString()),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		ClientCertPEM:      []byte(data.ClientCert.ValueString()),
+		ClientKeyPEM:       []byte(data.ClientKey.ValueString()),
+		ProxyURL:           data.ProxyURL.ValueString(),
+	}
+	if file := data.CACertFile.ValueString(); file != "" {
+		pem, err := os.ReadFile(file)
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "Unreadable CA Certificate File", err.Error())
+			return cfg, diags
+		}
+		cfg.CACertPEM = pem
+	}
+	if !data.Headers.IsNull() {
+		diags.Append(data.Headers.ElementsAs(ctx, &cfg.Headers, false)...)
+	}
+	return cfg, diags
+}
+
 func (p *pangolinProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSiteResource,
 		NewTargetResource,
 		NewRoleResource,
 		NewResourceResource,
+		NewOrgUserResource,
+		NewRoleMembershipResource,
+		NewInvitationResource,
+		NewUserTwoFactorResource,
+		NewIdpOIDCResource,
+		NewIdpOrgPolicyResource,
+		NewAPIKeyResource,
+		NewBlueprintResource,
 	}
 }
 
@@ -96,5 +368,17 @@ func (p *pangolinProvider) DataSources(_ context.Context) []func() datasource.Da
 	return []func() datasource.DataSource{
 		NewRoleDataSource,
 		NewSiteDataSource,
+		NewBlueprintDocumentDataSource,
+		NewDomainsDataSource,
+		NewDomainDataSource,
+		NewSitesDataSource,
+		NewResourcesDataSource,
+		NewSiteResourcesDataSource,
+		NewTargetsDataSource,
+		NewRolesDataSource,
+		NewUserAccessDataSource,
+		NewRequestLogsDataSource,
+		NewRequestAnalyticsDataSource,
+		NewResourceDataSource,
 	}
 }
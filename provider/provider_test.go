@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// setCredentialsFile points the provider at a credentials file with two
+// profiles and clears the connection environment variables.
+func setCredentialsFile(t *testing.T) {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "credentials")
+	content := "[default]\nbase_url = https://default.example.com/v1\ntoken = default-token\norg_id = default-org\n\n[staging]\ntoken = staging-token\n"
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PANGOLIN_CREDENTIALS_FILE", file)
+	for _, name := range []string{"PANGOLIN_BASE_URL", "PANGOLIN_TOKEN", "PANGOLIN_ORG_ID", "PANGOLIN_PROFILE"} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestResolveConnection_Precedence(t *testing.T) {
+	setCredentialsFile(t)
+
+	conn, diags := resolveConnection(pangolinProviderModel{})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if want := (connection{baseURL: "https://default.example.com/v1", token: "default-token", orgID: "default-org"}); conn != want {
+		t.Errorf("default profile: got %+v, want %+v", conn, want)
+	}
+
+	t.Setenv("PANGOLIN_PROFILE", "staging")
+	t.Setenv("PANGOLIN_ORG_ID", "env-org")
+	conn, diags = resolveConnection(pangolinProviderModel{})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if want := (connection{baseURL: "https://api.pangolin.net/v1", token: "staging-token", orgID: "env-org"}); conn != want {
+		t.Errorf("PANGOLIN_PROFILE: got %+v, want %+v", conn, want)
+	}
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PANGOLIN_TOKEN", "env-token")
+	conn, diags = resolveConnection(pangolinProviderModel{
+		Profile:   types.StringValue("default"),
+		TokenFile: types.StringValue(tokenFile),
+		OrgID:     types.StringValue("attr-org"),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if want := (connection{baseURL: "https://default.example.com/v1", token: "file-token", orgID: "attr-org"}); conn != want {
+		t.Errorf("attributes: got %+v, want %+v", conn, want)
+	}
+
+	conn, _ = resolveConnection(pangolinProviderModel{Token: types.StringValue("attr-token")})
+	if conn.token != "attr-token" {
+		t.Errorf("token attribute: got %q", conn.token)
+	}
+}
+
+func TestResolveConnection_Errors(t *testing.T) {
+	setCredentialsFile(t)
+
+	cases := map[string]pangolinProviderModel{
+		"Unknown Profile":       {Profile: types.StringValue("production")},
+		"Unreadable Token File": {TokenFile: types.StringValue(filepath.Join(t.TempDir(), "missing"))},
+	}
+	for summary, data := range cases {
+		_, diags := resolveConnection(data)
+		if !diags.HasError() || diags[0].Summary() != summary {
+			t.Errorf("%s: got %v", summary, diags)
+		}
+	}
+
+	t.Setenv("PANGOLIN_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+	if _, diags := resolveConnection(pangolinProviderModel{Profile: types.StringValue("staging")}); !diags.HasError() || diags[0].Summary() != "Credentials File Not Found" {
+		t.Errorf("missing file with a profile: got %v", diags)
+	}
+	if _, diags := resolveConnection(pangolinProviderModel{}); !diags.HasError() || diags[0].Summary() != "Missing API Token" {
+		t.Errorf("missing file without a profile: got %v", diags)
+	}
+}
+
+func TestCheckConnection(t *testing.T) {
+	dashboard := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "<!DOCTYPE html><html></html>")
+	}))
+	defer dashboard.Close()
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/":
+			// The health check is public.
+			fmt.Fprint(w, `{"success":true,"data":"Healthy"}`)
+		case r.Header.Get("Authorization") != "Bearer key1.secret":
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"success":false,"message":"Key is not valid"}`)
+		case r.URL.Path == "/orgs":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"success":false,"message":"Key does not have root access"}`)
+		default:
+			fmt.Fprint(w, `{"success":true,"data":{"actions":[{"actionId":"listRoles"}]}}`)
+		}
+	}))
+	defer api.Close()
+
+	actions := func(ids ...string) types.Set {
+		set, _ := types.SetValueFrom(t.Context(), types.StringType, ids)
+		return set
+	}
+	tests := map[string]struct {
+		url, token, orgID string
+		required          types.Set
+		want              string
+	}{
+		"dashboard port":               {dashboard.URL, "key1.secret", "acme", types.SetNull(types.StringType), "Not a Pangolin Integration API"},
+		"invalid token":                {api.URL, "key2.secret", "acme", types.SetNull(types.StringType), "Invalid API Token"},
+		"invalid token without org_id": {api.URL, "key2.secret", "", types.SetNull(types.StringType), "Invalid API Token"},
+		"invalid opaque token":         {api.URL, "opaque", "acme", types.SetNull(types.StringType), "Invalid API Token"},
+		"org key without org_id":       {api.URL, "key1.secret", "", types.SetNull(types.StringType), ""},
+		"lacks actions":                {api.URL, "key1.secret", "acme", actions("listRoles", "deleteRole", "createRole"), "API Token Lacks Actions"},
+		"has actions":                  {api.URL, "key1.secret", "acme", actions("listRoles"), ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := client.NewClient(tt.url, tt.token)
+			c.OrgID = tt.orgID
+			diags := checkConnection(t.Context(), c, tt.required)
+			if tt.want == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags[0].Summary() != tt.want {
+				t.Fatalf("got %v, want %q", diags, tt.want)
+			}
+			if tt.want == "API Token Lacks Actions" && !strings.Contains(diags[0].Detail(), "createRole, deleteRole") {
+				t.Errorf("detail does not list the missing actions: %s", diags[0].Detail())
+			}
+		})
+	}
+}
+
+func TestSkipConnectionCheck(t *testing.T) {
+	t.Setenv("PANGOLIN_SKIP_CONNECTION_CHECK", "")
+	if skipConnectionCheck(pangolinProviderModel{}) {
+		t.Error("skipped by default")
+	}
+	if !skipConnectionCheck(pangolinProviderModel{BaseURL: types.StringUnknown()}) {
+		t.Error("not skipped with an unknown base_url")
+	}
+	t.Setenv("PANGOLIN_SKIP_CONNECTION_CHECK", "true")
+	if !skipConnectionCheck(pangolinProviderModel{}) {
+		t.Error("PANGOLIN_SKIP_CONNECTION_CHECK ignored")
+	}
+	if skipConnectionCheck(pangolinProviderModel{SkipConnCheck: types.BoolValue(false)}) {
+		t.Error("the attribute does not override the environment")
+	}
+}
+
+func TestProviderSchema_SensitiveAttributes(t *testing.T) {
+	var resp provider.SchemaResponse
+	New("test")().Schema(t.Context(), provider.SchemaRequest{}, &resp)
+
+	// headers often carries credentials of a reverse proxy in front of Pangolin.
+	for _, name := range []string{"token", "headers"} {
+		if !resp.Schema.Attributes[name].IsSensitive() {
+			t.Errorf("%s can carry credentials and must be sensitive", name)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &apiKeyResource{}
+var _ resource.ResourceWithImportState = &apiKeyResource{}
+var _ resource.ResourceWithModifyPlan = &apiKeyResource{}
+
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+type apiKeyResource struct {
+	client *client.Client
+}
+
+type apiKeyResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	OrgID     types.String   `tfsdk:"org_id"`
+	Name      types.String   `tfsdk:"name"`
+	Actions   types.Set      `tfsdk:"actions"`
+	Key       types.String   `tfsdk:"key"`
+	LastChars types.String   `tfsdk:"last_chars"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *apiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an organization API key and the actions it is allowed to perform.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"actions": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "The action IDs the API key is allowed to perform, e.g. `listSites`. This set is authoritative and replaces any actions granted outside of Terraform.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(client.ActionIDs...)),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The API key token. Only available when the key is created by Terraform.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_chars": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The last characters of the API key, as shown in the dashboard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time the API key was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan defaults org_id to the provider's organization.
+func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data apiKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var actions []string
+	resp.Diagnostics.Append(data.Actions.ElementsAs(ctx, &actions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateAPIKey(ctx, data.OrgID.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating API key", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(created.ID)
+	data.Key = types.StringValue(created.Key)
+	data.LastChars = types.StringValue(created.LastChars)
+	data.CreatedAt = types.StringValue(created.CreatedAt)
+
+	// Save the key before setting actions so it is not lost if that fails.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sort.Strings(actions)
+	err = r.client.SetAPIKeyActions(ctx, data.OrgID.ValueString(), created.ID, actions)
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting API key actions", err.Error())
+		return
+	}
+}
+
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data apiKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	keys, err := r.client.ListAPIKeys(ctx, data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing API keys", err.Error())
+		return
+	}
+
+	var key *client.APIKey
+	for i := range keys {
+		if keys[i].ID == data.ID.ValueString() {
+			key = &keys[i]
+			break
+		}
+	}
+
+	if key == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(key.Name)
+	data.LastChars = types.StringValue(key.LastChars)
+	data.CreatedAt = types.StringValue(key.CreatedAt)
+
+	actions, err := r.client.GetAPIKeyActions(ctx, data.OrgID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading API key actions", err.Error())
+		return
+	}
+
+	actionsSet, diags := types.SetValueFrom(ctx, types.StringType, actions)
+	resp.Diagnostics.Append(diags...)
+	data.Actions = actionsSet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state apiKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var actions []string
+	resp.Diagnostics.Append(data.Actions.ElementsAs(ctx, &actions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sort.Strings(actions)
+	err := r.client.SetAPIKeyActions(ctx, state.OrgID.ValueString(), state.ID.ValueString(), actions)
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting API key actions", err.Error())
+		return
+	}
+
+	data.ID = state.ID
+	data.Key = state.Key
+	data.LastChars = state.LastChars
+	data.CreatedAt = state.CreatedAt
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data apiKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteAPIKey(ctx, data.OrgID.ValueString(), data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting API key", err.Error())
+		return
+	}
+}
+
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: org_id/api_key_id
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: org_id/api_key_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPIKey_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyConfig(`["listSites"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_api_key.test", "name", "tf-acc-key"),
+					resource.TestCheckResourceAttr("pangolin_api_key.test", "actions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("pangolin_api_key.test", "actions.*", "listSites"),
+					resource.TestCheckResourceAttrSet("pangolin_api_key.test", "id"),
+					resource.TestCheckResourceAttrSet("pangolin_api_key.test", "key"),
+				),
+			},
+			{
+				Config: testAccAPIKeyConfig(`["listSites", "getSite"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_api_key.test", "actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("pangolin_api_key.test", "actions.*", "getSite"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIKeyConfig(actions string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+resource "pangolin_api_key" "test" {
+  org_id  = %[3]q
+  name    = "tf-acc-key"
+  actions = %[4]s
+}
+`, testURL, testToken, testOrgID, actions)
+}
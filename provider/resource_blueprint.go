@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &blueprintResource{}
+var _ resource.ResourceWithModifyPlan = &blueprintResource{}
+
+func NewBlueprintResource() resource.Resource {
+	return &blueprintResource{}
+}
+
+type blueprintResource struct {
+	client *client.Client
+}
+
+type blueprintResourceModel struct {
+	ID          types.Int64    `tfsdk:"id"`
+	OrgID       types.String   `tfsdk:"org_id"`
+	Content     types.String   `tfsdk:"content"`
+	Config      types.Dynamic  `tfsdk:"config"`
+	ContentHash types.String   `tfsdk:"content_hash"`
+	Name        types.String   `tfsdk:"name"`
+	Succeeded   types.Bool     `tfsdk:"succeeded"`
+	Message     types.String   `tfsdk:"message"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *blueprintResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint"
+}
+
+func (r *blueprintResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Applies a declarative blueprint to an organization. The blueprint is re-applied whenever its content changes. " +
+			"Pangolin has no way to un-apply a blueprint, so destroying this resource only removes it from state and leaves the objects it created in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the blueprint returned by the most recent apply.",
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The blueprint as a raw YAML or JSON document. Exactly one of `content` or `config` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("config")),
+				},
+			},
+			"config": schema.DynamicAttribute{
+				Optional:            true,
+				MarkdownDescription: "The blueprint as an HCL object, e.g. the `json` output of `pangolin_blueprint_document` decoded with `jsondecode`.",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 of the canonical JSON form of the blueprint. Formatting-only changes to `content` do not change it.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name Pangolin gave the most recent apply.",
+			},
+			"succeeded": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the most recent apply succeeded.",
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The result message of the most recent apply.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true}),
+		},
+	}
+}
+
+func (r *blueprintResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan computes content_hash at plan time and only plans a new apply
+// when the canonical blueprint actually changed.
+func (r *blueprintResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan blueprintResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	canonical, diags := canonicalBlueprint(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || canonical == nil {
+		return
+	}
+	hash := blueprintHash(canonical)
+
+	plan.ContentHash = types.StringValue(hash)
+
+	if !req.State.Raw.IsNull() {
+		var state blueprintResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.ContentHash.ValueString() == hash && state.OrgID.Equal(plan.OrgID) {
+			plan.ID = state.ID
+			plan.Name = state.Name
+			plan.Succeeded = state.Succeeded
+			plan.Message = state.Message
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *blueprintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data blueprintResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *blueprintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data blueprintResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	bp, err := r.client.GetBlueprint(ctx, data.OrgID.ValueString(), int(data.ID.ValueInt64()))
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading blueprint", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(bp.Name)
+	data.Succeeded = types.BoolValue(bp.Succeeded)
+	data.Message = types.StringValue(bp.Message)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *blueprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state blueprintResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only re-apply when the canonical content changed, not on formatting changes.
+	if data.ContentHash.Equal(state.ContentHash) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *blueprintResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"Blueprint not un-applied",
+		"Pangolin cannot un-apply a blueprint. The blueprint was removed from Terraform state, but the objects it created remain in the organization.",
+	)
+}
+
+func (r *blueprintResource) apply(ctx context.Context, data *blueprintResourceModel) diag.Diagnostics {
+	canonical, diags := canonicalBlueprint(*data)
+	if diags.HasError() {
+		return diags
+	}
+
+	bp, err := r.client.ApplyBlueprint(ctx, data.OrgID.ValueString(), canonical)
+	if err != nil {
+		diags.AddError("Error applying blueprint", err.Error())
+		return diags
+	}
+
+	if !bp.Succeeded {
+		diags.AddError("Blueprint apply failed", fmt.Sprintf("Pangolin could not apply the blueprint: %s", bp.Message))
+		return diags
+	}
+
+	data.ID = types.Int64Value(int64(bp.ID))
+	data.ContentHash = types.StringValue(blueprintHash(canonical))
+	data.Name = types.StringValue(bp.Name)
+	data.Succeeded = types.BoolValue(bp.Succeeded)
+	data.Message = types.StringValue(bp.Message)
+
+	return diags
+}
+
+// canonicalBlueprint returns the canonical JSON of whichever of content or
+// config is set. It returns nil without error while the value is unknown.
+func canonicalBlueprint(data blueprintResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.Content.IsUnknown() || data.Config.IsUnknown() {
+		return nil, diags
+	}
+
+	var canonical []byte
+	var err error
+	var attrPath path.Path
+	if !data.Content.IsNull() {
+		attrPath = path.Root("content")
+		canonical, err = canonicalBlueprintFromText(data.Content.ValueString())
+	} else {
+		attrPath = path.Root("config")
+		canonical, err = canonicalBlueprintFromValue(data.Config)
+	}
+
+	if errors.Is(err, errUnknownValue) {
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Invalid Blueprint", err.Error())
+		return nil, diags
+	}
+
+	return canonical, diags
+}
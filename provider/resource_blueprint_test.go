@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlueprint_Basic(t *testing.T) {
+	const orgID = "blueprint-acc"
+	site := testAccBlueprintSite(t, orgID)
+
+	var id string
+	sameID := func(want bool) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			got := s.RootModule().Resources["pangolin_blueprint.test"].Primary.Attributes["id"]
+			if (got == id) != want {
+				return fmt.Errorf("blueprint id %s after %s, expected it to change: %t", got, id, !want)
+			}
+			id = got
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueprintConfig(orgID, fmt.Sprintf(`
+proxy-resources:
+  web:
+    name: Web
+    protocol: http
+    full-domain: web.example.com
+    targets:
+      - site: %s
+        hostname: web
+        port: 8080
+`, site)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pangolin_blueprint.test", "id"),
+					resource.TestCheckResourceAttrSet("pangolin_blueprint.test", "name"),
+					resource.TestCheckResourceAttrSet("pangolin_blueprint.test", "content_hash"),
+					resource.TestCheckResourceAttr("pangolin_blueprint.test", "succeeded", "true"),
+					resource.TestCheckResourceAttrSet("pangolin_blueprint.test", "message"),
+					sameID(false),
+				),
+			},
+			{
+				// The same blueprint as JSON is not applied again.
+				Config: testAccBlueprintConfig(orgID, fmt.Sprintf(
+					`{"proxy-resources":{"web":{"targets":[{"port":8080,"hostname":"web","site":%q}],"full-domain":"web.example.com","protocol":"http","name":"Web"}}}`, site)),
+				Check: sameID(true),
+			},
+			{
+				// A change of content is applied again.
+				Config: testAccBlueprintConfig(orgID, fmt.Sprintf(`
+proxy-resources:
+  web:
+    name: Web
+    protocol: http
+    full-domain: web.example.com
+    targets:
+      - site: %s
+        hostname: web
+        port: 8081
+`, site)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_blueprint.test", "succeeded", "true"),
+					sameID(false),
+				),
+			},
+			{
+				Config: testAccBlueprintConfig(orgID, `
+proxy-resources:
+  web:
+    name: Web
+    protocol: http
+    full-domain: web.example.com
+    targets:
+      - site: no-such-site
+        hostname: web
+        port: 8081
+`),
+				ExpectError: regexp.MustCompile(`Blueprint apply failed`),
+			},
+		},
+	})
+}
+
+func TestAccBlueprint_FailedApply(t *testing.T) {
+	const orgID = "blueprint-acc-failed"
+	testAccBlueprintSite(t, orgID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueprintConfig(orgID, `
+proxy-resources:
+  web:
+    name: Web
+    protocol: http
+    full-domain: web.example.com
+    targets:
+      - site: no-such-site
+        hostname: web
+        port: 8080
+`),
+				ExpectError: regexp.MustCompile(`(?s)Blueprint apply failed.*no-such-site`),
+			},
+		},
+	})
+}
+
+// testAccBlueprintSite creates an organization of the fake server with a site
+// and returns the nice ID blueprints refer to the site by.
+func testAccBlueprintSite(t *testing.T, orgID string) string {
+	fake := testAccFake(t)
+	fake.AddOrg(orgID)
+	site, err := client.NewClient(testURL, testToken).GetSite(t.Context(), fake.AddSite(orgID, "Blueprint Site"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return site.NiceID
+}
+
+func testAccBlueprintConfig(orgID string, content string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+resource "pangolin_blueprint" "test" {
+  org_id  = %[3]q
+  content = %[4]q
+}
+`, testURL, testToken, orgID, content)
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &idpOIDCResource{}
+var _ resource.ResourceWithImportState = &idpOIDCResource{}
+
+func NewIdpOIDCResource() resource.Resource {
+	return &idpOIDCResource{}
+}
+
+type idpOIDCResource struct {
+	client *client.Client
+}
+
+type idpOIDCResourceModel struct {
+	ID                  types.Int64    `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	ClientID            types.String   `tfsdk:"client_id"`
+	ClientSecret        types.String   `tfsdk:"client_secret"`
+	ClientSecretVersion types.Int64    `tfsdk:"client_secret_version"`
+	AuthURL             types.String   `tfsdk:"auth_url"`
+	TokenURL            types.String   `tfsdk:"token_url"`
+	IdentifierPath      types.String   `tfsdk:"identifier_path"`
+	EmailPath           types.String   `tfsdk:"email_path"`
+	NamePath            types.String   `tfsdk:"name_path"`
+	Scopes              types.List     `tfsdk:"scopes"`
+	AutoProvision       types.Bool     `tfsdk:"auto_provision"`
+	RedirectURL         types.String   `tfsdk:"redirect_url"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *idpOIDCResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idp_oidc"
+}
+
+func (r *idpOIDCResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an OpenID Connect identity provider. Requires Terraform 1.11 or later for the write-only `client_secret`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the identity provider.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the identity provider.",
+			},
+			"client_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The OIDC client ID.",
+			},
+			"client_secret": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The OIDC client secret. This value is write-only and never stored in state; change `client_secret_version` to send a new secret.",
+			},
+			"client_secret_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "An arbitrary version number for `client_secret`. Changing it sends the current `client_secret` to Pangolin.",
+			},
+			"auth_url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The authorization endpoint URL.",
+				Validators: []validator.String{
+					httpURLValidator{},
+				},
+			},
+			"token_url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The token endpoint URL.",
+				Validators: []validator.String{
+					httpURLValidator{},
+				},
+			},
+			"identifier_path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The JMESPath expression selecting the user identifier from the ID token claims (e.g. `sub`).",
+				Validators: []validator.String{
+					jmespathValidator{},
+				},
+			},
+			"email_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The JMESPath expression selecting the email from the ID token claims (e.g. `email`).",
+				Validators: []validator.String{
+					jmespathValidator{},
+				},
+			},
+			"name_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The JMESPath expression selecting the display name from the ID token claims (e.g. `name`).",
+				Validators: []validator.String{
+					jmespathValidator{},
+				},
+			},
+			"scopes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The OIDC scopes to request. Defaults to `openid`, `profile` and `email`.",
+				Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("openid"),
+					types.StringValue("profile"),
+					types.StringValue("email"),
+				})),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"auto_provision": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether users signing in through this identity provider are provisioned automatically.",
+			},
+			"redirect_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The redirect URL to register with the identity provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *idpOIDCResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+func (r *idpOIDCResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data idpOIDCResourceModel
+	var secret types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only values are only available in the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret"), &secret)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	idp, diags := expandOIDCIdp(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idp.ClientSecret = secret.ValueString()
+
+	created, err := r.client.CreateOIDCIdp(ctx, idp)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating OIDC identity provider", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(int64(created.ID))
+	data.RedirectURL = types.StringValue(created.RedirectURL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *idpOIDCResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data idpOIDCResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	idp, err := r.client.GetOIDCIdp(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading OIDC identity provider", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(idp.Name)
+	data.ClientID = types.StringValue(idp.ClientID)
+	data.AuthURL = types.StringValue(idp.AuthURL)
+	data.TokenURL = types.StringValue(idp.TokenURL)
+	data.IdentifierPath = types.StringValue(idp.IdentifierPath)
+	if idp.EmailPath != "" || !data.EmailPath.IsNull() {
+		data.EmailPath = types.StringValue(idp.EmailPath)
+	}
+	if idp.NamePath != "" || !data.NamePath.IsNull() {
+		data.NamePath = types.StringValue(idp.NamePath)
+	}
+	scopes, diags := types.ListValueFrom(ctx, types.StringType, strings.Fields(idp.Scopes))
+	resp.Diagnostics.Append(diags...)
+	data.Scopes = scopes
+	data.AutoProvision = types.BoolValue(idp.AutoProvision)
+	if idp.RedirectURL != "" {
+		data.RedirectURL = types.StringValue(idp.RedirectURL)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *idpOIDCResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state idpOIDCResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	idp, diags := expandOIDCIdp(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ClientSecretVersion.Equal(state.ClientSecretVersion) {
+		var secret types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret"), &secret)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		idp.ClientSecret = secret.ValueString()
+	}
+
+	err := r.client.UpdateOIDCIdp(ctx, int(state.ID.ValueInt64()), idp)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating OIDC identity provider", err.Error())
+		return
+	}
+
+	data.ID = state.ID
+	data.RedirectURL = state.RedirectURL
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *idpOIDCResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data idpOIDCResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteIdp(ctx, int(data.ID.ValueInt64()))
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting OIDC identity provider", err.Error())
+		return
+	}
+}
+
+func (r *idpOIDCResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: idp_id
+	idpID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected idp_id to be an integer. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idpID)...)
+}
+
+func expandOIDCIdp(ctx context.Context, data *idpOIDCResourceModel) (*client.OIDCIdp, diag.Diagnostics) {
+	var scopes []string
+	diags := data.Scopes.ElementsAs(ctx, &scopes, false)
+
+	return &client.OIDCIdp{
+		Name:           data.Name.ValueString(),
+		ClientID:       data.ClientID.ValueString(),
+		AuthURL:        data.AuthURL.ValueString(),
+		TokenURL:       data.TokenURL.ValueString(),
+		IdentifierPath: data.IdentifierPath.ValueString(),
+		EmailPath:      data.EmailPath.ValueString(),
+		NamePath:       data.NamePath.ValueString(),
+		Scopes:         strings.Join(scopes, " "),
+		AutoProvision:  data.AutoProvision.ValueBool(),
+	}, diags
+}
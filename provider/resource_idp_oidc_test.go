@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccIdpOIDC_ClientSecretRotation(t *testing.T) {
+	// The fake exposes the secret the API never returns.
+	fake := testAccFake(t)
+
+	checkSecret := func(want string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			id, err := strconv.Atoi(s.RootModule().Resources["pangolin_idp_oidc.test"].Primary.ID)
+			if err != nil {
+				return err
+			}
+			got, ok := fake.IdpClientSecret(id)
+			if !ok {
+				return fmt.Errorf("IdP %d not found", id)
+			}
+			if got != want {
+				return fmt.Errorf("expected client secret %q, got %q", want, got)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdpOIDCConfig("first-secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_idp_oidc.test", "name", "tf-acc-oidc"),
+					resource.TestCheckResourceAttr("pangolin_idp_oidc.test", "client_secret_version", "1"),
+					resource.TestCheckNoResourceAttr("pangolin_idp_oidc.test", "client_secret"),
+					resource.TestCheckResourceAttrSet("pangolin_idp_oidc.test", "redirect_url"),
+					checkSecret("first-secret"),
+				),
+			},
+			{
+				// A new secret alone is not sent: write-only values cause no diff.
+				Config:   testAccIdpOIDCConfig("second-secret", 1),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccIdpOIDCConfig("second-secret", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_idp_oidc.test", "client_secret_version", "2"),
+					resource.TestCheckNoResourceAttr("pangolin_idp_oidc.test", "client_secret"),
+					checkSecret("second-secret"),
+				),
+			},
+			{
+				ResourceName:            "pangolin_idp_oidc.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret_version"},
+			},
+		},
+	})
+}
+
+func testAccIdpOIDCConfig(secret string, version int) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+resource "pangolin_idp_oidc" "test" {
+  name                  = "tf-acc-oidc"
+  client_id             = "pangolin"
+  client_secret         = %[3]q
+  client_secret_version = %[4]d
+  auth_url              = "https://idp.example.com/auth"
+  token_url             = "https://idp.example.com/token"
+  identifier_path       = "sub"
+  email_path            = "email"
+  scopes                = ["openid", "profile", "email"]
+}
+`, testURL, testToken, secret, version)
+}
@@ -0,0 +1,449 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jmespath/go-jmespath"
+)
+
+var _ resource.Resource = &idpOrgPolicyResource{}
+var _ resource.ResourceWithImportState = &idpOrgPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &idpOrgPolicyResource{}
+
+func NewIdpOrgPolicyResource() resource.Resource {
+	return &idpOrgPolicyResource{}
+}
+
+type idpOrgPolicyResource struct {
+	client *client.Client
+}
+
+type idpOrgPolicyResourceModel struct {
+	ID          types.String       `tfsdk:"id"`
+	IdpID       types.Int64        `tfsdk:"idp_id"`
+	OrgID       types.String       `tfsdk:"org_id"`
+	RoleMapping types.String       `tfsdk:"role_mapping"`
+	RoleRules   []idpRoleRuleModel `tfsdk:"role_rules"`
+	DefaultRole types.String       `tfsdk:"default_role"`
+	OrgMapping  types.String       `tfsdk:"org_mapping"`
+	Timeouts    timeouts.Value     `tfsdk:"timeouts"`
+}
+
+type idpRoleRuleModel struct {
+	Condition types.String `tfsdk:"condition"`
+	Role      types.String `tfsdk:"role"`
+}
+
+func (r *idpOrgPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idp_org_policy"
+}
+
+func (r *idpOrgPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the role and organization mapping policy of an identity provider for an organization. " +
+			"The role mapping can be given as a raw JMESPath expression in `role_mapping`, or built from `role_rules` and `default_role` " +
+			"so role names can reference `pangolin_role` resources. Plans warn about role names that do not exist in the organization yet.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the policy in the format `idp_id/org_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idp_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the identity provider.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_mapping": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The JMESPath expression evaluated against the ID token claims that returns the name of the role to assign. Computed when `role_rules` or `default_role` are used.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					jmespathValidator{},
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("role_rules"),
+						path.MatchRoot("default_role"),
+					),
+				},
+			},
+			"role_rules": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Ordered rules assigning a role when a condition matches. The first matching rule wins.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"condition": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "A JMESPath expression evaluated against the ID token claims, e.g. `contains(groups, 'ops')`.",
+							Validators: []validator.String{
+								jmespathValidator{},
+							},
+						},
+						"role": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the role assigned when the condition matches.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"default_role": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the role assigned when no rule in `role_rules` matches.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"org_mapping": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The JMESPath expression deciding whether a user is provisioned into the organization. `{{orgId}}` is replaced with the organization ID before evaluation.",
+				Validators: []validator.String{
+					jmespathValidator{},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *idpOrgPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan renders role_rules into role_mapping so the generated expression
+// shows up in the plan, and checks the roles it names.
+func (r *idpOrgPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data idpOrgPolicyResourceModel
+	var configured types.String
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role_mapping"), &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RoleRules == nil && data.DefaultRole.IsNull() {
+		// Without rules or an expression, the policy maps no role; do not
+		// keep an expression generated from rules that were removed.
+		if configured.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("role_mapping"), "")...)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkRolesExist(ctx, &data)...)
+
+	expr, known := buildRoleMapping(data.RoleRules, data.DefaultRole)
+	if !known {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("role_mapping"), types.StringUnknown())...)
+		return
+	}
+
+	if _, err := jmespath.Compile(expr); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_rules"),
+			"Invalid Role Mapping",
+			fmt.Sprintf("The role mapping generated from role_rules is not a valid JMESPath expression: %s\n\nExpression: %s", err, expr),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("role_mapping"), expr)...)
+}
+
+func (r *idpOrgPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data idpOrgPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	policy := &client.IdpOrgPolicy{
+		RoleMapping: data.RoleMapping.ValueString(),
+		OrgMapping:  data.OrgMapping.ValueString(),
+	}
+
+	err := r.client.CreateIdpOrgPolicy(ctx, int(data.IdpID.ValueInt64()), data.OrgID.ValueString(), policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating IdP org policy", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%s", data.IdpID.ValueInt64(), data.OrgID.ValueString()))
+	if data.RoleMapping.IsUnknown() {
+		data.RoleMapping = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *idpOrgPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data idpOrgPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	policies, err := r.client.ListIdpOrgPolicies(ctx, int(data.IdpID.ValueInt64()))
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error listing IdP org policies", err.Error())
+		return
+	}
+
+	var policy *client.IdpOrgPolicy
+	for i := range policies {
+		if policies[i].OrgID == data.OrgID.ValueString() {
+			policy = &policies[i]
+			break
+		}
+	}
+
+	if policy == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.RoleMapping = types.StringValue(policy.RoleMapping)
+	if policy.OrgMapping != "" || !data.OrgMapping.IsNull() {
+		data.OrgMapping = types.StringValue(policy.OrgMapping)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *idpOrgPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state idpOrgPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	policy := &client.IdpOrgPolicy{
+		RoleMapping: data.RoleMapping.ValueString(),
+		OrgMapping:  data.OrgMapping.ValueString(),
+	}
+
+	err := r.client.UpdateIdpOrgPolicy(ctx, int(data.IdpID.ValueInt64()), data.OrgID.ValueString(), policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating IdP org policy", err.Error())
+		return
+	}
+
+	data.ID = state.ID
+	if data.RoleMapping.IsUnknown() {
+		data.RoleMapping = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *idpOrgPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data idpOrgPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteIdpOrgPolicy(ctx, int(data.IdpID.ValueInt64()), data.OrgID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting IdP org policy", err.Error())
+		return
+	}
+}
+
+func (r *idpOrgPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: idp_id/org_id
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: idp_id/org_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	idpID, err := strconv.ParseInt(idParts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected idp_id to be an integer. Got: %q", idParts[0]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("idp_id"), idpID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), idParts[1])...)
+}
+
+// checkRolesExist warns about roles named in role_rules and default_role that
+// do not exist in the organization, so a renamed or deleted role shows up in
+// the plan instead of silently breaking provisioning. It only warns since the
+// role may be created by the same apply, and it skips names that are unknown.
+func (r *idpOrgPolicyResource) checkRolesExist(ctx context.Context, data *idpOrgPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil || data.OrgID.IsUnknown() || data.OrgID.IsNull() {
+		return diags
+	}
+
+	var names []string
+	for _, rule := range data.RoleRules {
+		if !rule.Role.IsUnknown() {
+			names = append(names, rule.Role.ValueString())
+		}
+	}
+	if !data.DefaultRole.IsNull() && !data.DefaultRole.IsUnknown() {
+		names = append(names, data.DefaultRole.ValueString())
+	}
+	if len(names) == 0 {
+		return diags
+	}
+
+	roles, err := r.client.ListRoles(ctx, data.OrgID.ValueString())
+	if err != nil {
+		diags.AddWarning("Roles Not Checked", fmt.Sprintf("Could not list the roles of organization %q: %s", data.OrgID.ValueString(), err))
+		return diags
+	}
+
+	existing := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		existing[role.Name] = true
+	}
+
+	for _, name := range names {
+		if !existing[name] {
+			diags.AddWarning(
+				"Role not found",
+				fmt.Sprintf("The IdP org policy maps users to role %q, which does not exist in organization %q. "+
+					"Users mapped to it are not provisioned unless the role is created, e.g. by this apply.", name, data.OrgID.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// buildRoleMapping renders ordered rules into a JMESPath expression of the form
+// `cond1 && 'Role1' || cond2 && 'Role2' || 'Default'`. It reports false when a
+// rule is not known yet.
+func buildRoleMapping(rules []idpRoleRuleModel, defaultRole types.String) (string, bool) {
+	var parts []string
+	for _, rule := range rules {
+		if rule.Condition.IsUnknown() || rule.Role.IsUnknown() {
+			return "", false
+		}
+		parts = append(parts, fmt.Sprintf("(%s) && %s", rule.Condition.ValueString(), jmespathRawString(rule.Role.ValueString())))
+	}
+
+	if defaultRole.IsUnknown() {
+		return "", false
+	}
+	if !defaultRole.IsNull() {
+		parts = append(parts, jmespathRawString(defaultRole.ValueString()))
+	}
+
+	return strings.Join(parts, " || "), true
+}
+
+// jmespathRawString quotes s as a JMESPath raw string literal.
+func jmespathRawString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/groteck/terraform-provider-pangolin/internal/fakeserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jmespath/go-jmespath"
+)
+
+func TestBuildRoleMapping(t *testing.T) {
+	rules := []idpRoleRuleModel{
+		{Condition: types.StringValue("contains(groups, 'admins')"), Role: types.StringValue("Admin")},
+		{Condition: types.StringValue("contains(groups, 'ops')"), Role: types.StringValue("Operator's")},
+	}
+
+	expr, known := buildRoleMapping(rules, types.StringValue("Member"))
+	if !known {
+		t.Fatal("expected expression to be known")
+	}
+
+	cases := []struct {
+		groups []interface{}
+		want   string
+	}{
+		{groups: []interface{}{"admins", "ops"}, want: "Admin"},
+		{groups: []interface{}{"ops"}, want: "Operator's"},
+		{groups: []interface{}{"dev"}, want: "Member"},
+	}
+
+	for _, tc := range cases {
+		got, err := jmespath.Search(expr, map[string]interface{}{"groups": tc.groups})
+		if err != nil {
+			t.Fatalf("evaluating %q: %v", expr, err)
+		}
+		if got != tc.want {
+			t.Errorf("groups %v: expected %q, got %v", tc.groups, tc.want, got)
+		}
+	}
+}
+
+func TestBuildRoleMapping_Unknown(t *testing.T) {
+	rules := []idpRoleRuleModel{
+		{Condition: types.StringValue("contains(groups, 'admins')"), Role: types.StringUnknown()},
+	}
+
+	if _, known := buildRoleMapping(rules, types.StringNull()); known {
+		t.Fatal("expected expression to be unknown")
+	}
+}
+
+func TestCheckRolesExist(t *testing.T) {
+	fake := fakeserver.New()
+	defer fake.Close()
+	fake.AddOrg("acme")
+	r := &idpOrgPolicyResource{client: client.NewClient(fake.BaseURL(), fakeserver.Token)}
+
+	cases := []struct {
+		name     string
+		data     idpOrgPolicyResourceModel
+		warnings int
+	}{
+		{
+			name: "existing roles",
+			data: idpOrgPolicyResourceModel{
+				OrgID:       types.StringValue("acme"),
+				RoleRules:   []idpRoleRuleModel{{Condition: types.StringValue("admin"), Role: types.StringValue("Admin")}},
+				DefaultRole: types.StringValue("Member"),
+			},
+		},
+		{
+			name: "missing roles",
+			data: idpOrgPolicyResourceModel{
+				OrgID:       types.StringValue("acme"),
+				RoleRules:   []idpRoleRuleModel{{Condition: types.StringValue("ops"), Role: types.StringValue("Ops")}},
+				DefaultRole: types.StringValue("Guest"),
+			},
+			warnings: 2,
+		},
+		{
+			name: "unknown role",
+			data: idpOrgPolicyResourceModel{
+				OrgID:       types.StringValue("acme"),
+				RoleRules:   []idpRoleRuleModel{{Condition: types.StringValue("ops"), Role: types.StringUnknown()}},
+				DefaultRole: types.StringNull(),
+			},
+		},
+		{
+			name: "unknown organization",
+			data: idpOrgPolicyResourceModel{
+				OrgID:       types.StringUnknown(),
+				DefaultRole: types.StringValue("Guest"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := r.checkRolesExist(t.Context(), &tc.data)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if got := diags.WarningsCount(); got != tc.warnings {
+				t.Errorf("expected %d warnings, got %d: %v", tc.warnings, got, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &invitationResource{}
+var _ resource.ResourceWithImportState = &invitationResource{}
+var _ resource.ResourceWithModifyPlan = &invitationResource{}
+
+func NewInvitationResource() resource.Resource {
+	return &invitationResource{}
+}
+
+type invitationResource struct {
+	client *client.Client
+}
+
+type invitationResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	OrgID      types.String   `tfsdk:"org_id"`
+	Email      types.String   `tfsdk:"email"`
+	RoleID     types.Int64    `tfsdk:"role_id"`
+	ValidHours types.Int64    `tfsdk:"valid_hours"`
+	SendEmail  types.Bool     `tfsdk:"send_email"`
+	InviteLink types.String   `tfsdk:"invite_link"`
+	ExpiresAt  types.String   `tfsdk:"expires_at"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *invitationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invitation"
+}
+
+func (r *invitationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an invitation for an internal user to join an organization. " +
+			"An expired invitation is planned for re-creation. Once the user accepts, the invitation is removed from state and can be removed from the configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the invitation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email address of the invited user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`),
+						"Email must be a valid email address",
+					),
+				},
+			},
+			"role_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the role the user is given when accepting the invitation.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"valid_hours": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The number of hours the invitation is valid for (1 to 168). Only used when the invitation is created; changing it later does not change `expires_at`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 168),
+				},
+			},
+			"send_email": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether Pangolin emails the invitation link to the user.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"invite_link": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The invitation link. Only available when the invitation is created by Terraform.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time the invitation expires, in RFC 3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Delete: true}),
+		},
+	}
+}
+
+func (r *invitationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan defaults org_id to the provider's organization.
+func (r *invitationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
+func (r *invitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data invitationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	created, err := r.client.CreateInvitation(ctx,
+		data.OrgID.ValueString(),
+		data.Email.ValueString(),
+		int(data.RoleID.ValueInt64()),
+		int(data.ValidHours.ValueInt64()),
+		data.SendEmail.ValueBool(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating invitation", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(created.ID)
+	data.InviteLink = types.StringValue(created.InviteLink)
+	data.ExpiresAt = types.StringValue(formatMillis(created.ExpiresAt))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *invitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data invitationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	invitations, err := r.client.ListInvitations(ctx, data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing invitations", err.Error())
+		return
+	}
+
+	var invitation *client.Invitation
+	for i := range invitations {
+		if invitations[i].ID == data.ID.ValueString() {
+			invitation = &invitations[i]
+			break
+		}
+	}
+
+	// The invitation was accepted or revoked.
+	if invitation == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Drop expired invitations so the next plan creates a fresh one.
+	if time.UnixMilli(invitation.ExpiresAt).Before(time.Now()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if !strings.EqualFold(invitation.Email, data.Email.ValueString()) {
+		data.Email = types.StringValue(invitation.Email)
+	}
+	data.RoleID = types.Int64Value(int64(invitation.RoleID))
+	data.ExpiresAt = types.StringValue(formatMillis(invitation.ExpiresAt))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *invitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// valid_hours is the only attribute that does not force replacement, and
+	// it only applies on creation, so there is nothing to send.
+	var data, state invitationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	data.InviteLink = state.InviteLink
+	data.ExpiresAt = state.ExpiresAt
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *invitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data invitationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteInvitation(ctx, data.OrgID.ValueString(), data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting invitation", err.Error())
+		return
+	}
+}
+
+func (r *invitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: org_id/invite_id
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: org_id/invite_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	// valid_hours and invite_link cannot be read back and stay null;
+	// valid_hours is taken from the configuration by the next apply.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("send_email"), false)...)
+}
+
+// formatMillis renders a Unix timestamp in milliseconds as RFC 3339.
+func formatMillis(ms int64) string {
+	return time.UnixMilli(ms).UTC().Format(time.RFC3339)
+}
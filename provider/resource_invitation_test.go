@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccInvitation_Basic(t *testing.T) {
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvitationConfig("invitee@example.com", 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_invitation.test", "email", "invitee@example.com"),
+					resource.TestCheckResourceAttr("pangolin_invitation.test", "valid_hours", "24"),
+					resource.TestCheckResourceAttr("pangolin_invitation.test", "send_email", "false"),
+					resource.TestCheckResourceAttrSet("pangolin_invitation.test", "id"),
+					resource.TestCheckResourceAttrSet("pangolin_invitation.test", "invite_link"),
+					resource.TestCheckResourceAttrSet("pangolin_invitation.test", "expires_at"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["pangolin_invitation.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// valid_hours only applies on creation: changing it keeps the
+				// invitation.
+				Config: testAccInvitationConfig("invitee@example.com", 48),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pangolin_invitation.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_invitation.test", "valid_hours", "48"),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources["pangolin_invitation.test"].Primary.ID; got != id {
+							return fmt.Errorf("expected invitation %s to be kept, got %s", id, got)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "pangolin_invitation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/%s", testOrgID, s.RootModule().Resources["pangolin_invitation.test"].Primary.ID), nil
+				},
+				// Neither can be read back from the API.
+				ImportStateVerifyIgnore: []string{"valid_hours", "invite_link"},
+			},
+		},
+	})
+}
+
+// TestAccInvitation_ImportKeepsInvitation checks that the first apply after
+// an import updates the invitation in place instead of revoking it and
+// sending a new one.
+func TestAccInvitation_ImportKeepsInvitation(t *testing.T) {
+	fake := testAccFake(t)
+	member, _ := fake.RoleByName(testOrgID, "Member")
+	roleID := member.RoleID
+	invitation, err := client.NewClient(testURL, testToken).CreateInvitation(t.Context(), testOrgID, "imported@example.com", roleID, 24, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+import {
+  to = pangolin_invitation.test
+  id = "%[3]s/%[4]s"
+}
+
+resource "pangolin_invitation" "test" {
+  org_id      = %[3]q
+  email       = "imported@example.com"
+  role_id     = %[5]d
+  valid_hours = 24
+}
+`, testURL, testToken, testOrgID, invitation.ID, roleID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pangolin_invitation.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_invitation.test", "id", invitation.ID),
+					resource.TestCheckResourceAttr("pangolin_invitation.test", "valid_hours", "24"),
+					resource.TestCheckNoResourceAttr("pangolin_invitation.test", "invite_link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInvitationConfig(email string, validHours int) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_role" "member" {
+  org_id = %[3]q
+  name   = "Member"
+}
+
+resource "pangolin_invitation" "test" {
+  org_id      = %[3]q
+  email       = %[4]q
+  role_id     = data.pangolin_role.member.id
+  valid_hours = %[5]d
+}
+`, testURL, testToken, testOrgID, email, validHours)
+}
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &orgUserResource{}
+var _ resource.ResourceWithImportState = &orgUserResource{}
+var _ resource.ResourceWithModifyPlan = &orgUserResource{}
+
+func NewOrgUserResource() resource.Resource {
+	return &orgUserResource{}
+}
+
+type orgUserResource struct {
+	client *client.Client
+}
+
+type orgUserResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	OrgID    types.String   `tfsdk:"org_id"`
+	IdpID    types.Int64    `tfsdk:"idp_id"`
+	Username types.String   `tfsdk:"username"`
+	Email    types.String   `tfsdk:"email"`
+	Name     types.String   `tfsdk:"name"`
+	RoleID   types.Int64    `tfsdk:"role_id"`
+	Type     types.String   `tfsdk:"type"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *orgUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_user"
+}
+
+func (r *orgUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an identity provider backed user in an organization. Internal users sign in with local accounts and are onboarded with `pangolin_invitation` instead.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"idp_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the identity provider the user signs in with.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username of the user as reported by the identity provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The email address of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The display name of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the role assigned to the user. Do not combine with a `pangolin_role_membership` for the same user.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *orgUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan defaults org_id to the provider's organization.
+func (r *orgUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
+func (r *orgUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data orgUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	user := &client.OrgUser{
+		Username: data.Username.ValueString(),
+		Email:    data.Email.ValueString(),
+		Name:     data.Name.ValueString(),
+		Type:     "oidc",
+		IdpID:    int(data.IdpID.ValueInt64()),
+		RoleID:   int(data.RoleID.ValueInt64()),
+	}
+
+	created, err := r.client.CreateOrgUser(ctx, data.OrgID.ValueString(), user)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating organization user", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(created.ID)
+	data.Type = types.StringValue(created.Type)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data orgUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	user, err := r.client.GetOrgUser(ctx, data.OrgID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading organization user", err.Error())
+		return
+	}
+
+	data.Username = types.StringValue(user.Username)
+	data.IdpID = types.Int64Value(int64(user.IdpID))
+	data.RoleID = types.Int64Value(int64(user.RoleID))
+	data.Type = types.StringValue(user.Type)
+	if user.Email != "" || !data.Email.IsNull() {
+		data.Email = types.StringValue(user.Email)
+	}
+	if user.Name != "" || !data.Name.IsNull() {
+		data.Name = types.StringValue(user.Name)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state orgUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Every other attribute forces replacement, so only the role can change here.
+	err := r.client.AddRoleToUser(ctx, int(data.RoleID.ValueInt64()), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization user role", err.Error())
+		return
+	}
+
+	data.ID = state.ID
+	data.Type = state.Type
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data orgUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteOrgUser(ctx, data.OrgID.ValueString(), data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting organization user", err.Error())
+		return
+	}
+}
+
+func (r *orgUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: org_id/user_id
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: org_id/user_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
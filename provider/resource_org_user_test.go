@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOrgUser_Basic(t *testing.T) {
+	// Creating IdP users needs a configured IdP, which only the fake skips.
+	testAccFake(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrgUserDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrgUserConfig("Member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_org_user.test", "username", "oidc-user"),
+					resource.TestCheckResourceAttr("pangolin_org_user.test", "type", "oidc"),
+					resource.TestCheckResourceAttrPair("pangolin_org_user.test", "role_id", "data.pangolin_role.test", "id"),
+					resource.TestCheckResourceAttrSet("pangolin_org_user.test", "id"),
+				),
+			},
+			{
+				Config: testAccOrgUserConfig("Admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("pangolin_org_user.test", "role_id", "data.pangolin_role.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "pangolin_org_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/%s", testOrgID, s.RootModule().Resources["pangolin_org_user.test"].Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+// testAccCheckOrgUserDestroy checks that destroyed users left the organization.
+func testAccCheckOrgUserDestroy(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := client.NewClient(testURL, testToken)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "pangolin_org_user" {
+				continue
+			}
+			_, err := c.GetOrgUser(t.Context(), rs.Primary.Attributes["org_id"], rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("user %s is still a member of organization %s", rs.Primary.ID, rs.Primary.Attributes["org_id"])
+			}
+			if !client.IsNotFound(err) {
+				return fmt.Errorf("checking user %s: %w", rs.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccOrgUserConfig(role string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+data "pangolin_role" "test" {
+  org_id = %[3]q
+  name   = %[4]q
+}
+
+resource "pangolin_org_user" "test" {
+  org_id   = %[3]q
+  idp_id   = 1
+  username = "oidc-user"
+  email    = "oidc-user@example.com"
+  role_id  = data.pangolin_role.test.id
+}
+`, testURL, testToken, testOrgID, role)
+}
@@ -3,10 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,6 +20,7 @@ import (
 
 var _ resource.Resource = &resourceResource{}
 var _ resource.ResourceWithImportState = &resourceResource{}
+var _ resource.ResourceWithModifyPlan = &resourceResource{}
 
 func NewResourceResource() resource.Resource {
 	return &resourceResource{}
@@ -31,20 +31,21 @@ type resourceResource struct {
 }
 
 type resourceResourceModel struct {
-	ID        types.Int64  `tfsdk:"id"`
-	OrgID     types.String `tfsdk:"org_id"`
-	Name      types.String `tfsdk:"name"`
-	Protocol  types.String `tfsdk:"protocol"`
-	Http      types.Bool   `tfsdk:"http"`
-	Subdomain types.String `tfsdk:"subdomain"`
-	DomainID  types.String `tfsdk:"domain_id"`
+	ID        types.Int64    `tfsdk:"id"`
+	OrgID     types.String   `tfsdk:"org_id"`
+	Name      types.String   `tfsdk:"name"`
+	Protocol  types.String   `tfsdk:"protocol"`
+	Http      types.Bool     `tfsdk:"http"`
+	Subdomain types.String   `tfsdk:"subdomain"`
+	DomainID  types.String   `tfsdk:"domain_id"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_resource"
 }
 
-func (r *resourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an app-style resource (HTTP/TCP/UDP).",
 		Attributes: map[string]schema.Attribute{
@@ -56,9 +57,11 @@ func (r *resourceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"org_id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The ID of the organization.",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -70,7 +73,7 @@ func (r *resourceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Required:            true,
 				MarkdownDescription: "The protocol of the resource (tcp or udp).",
 				Validators: []validator.String{
-					stringvalidator.OneOf("tcp", "udp"),
+					stringvalidator.OneOf(client.ResourceProtocolValues()...),
 				},
 			},
 			"http": schema.BoolAttribute{
@@ -88,6 +91,9 @@ func (r *resourceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				MarkdownDescription: "The ID of the domain.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -103,6 +109,11 @@ func (r *resourceResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.client = c
 }
 
+// ModifyPlan defaults org_id to the provider's organization.
+func (r *resourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
 func (r *resourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resourceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -110,6 +121,15 @@ func (r *resourceResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	res := &client.Resource{
 		Name:      data.Name.ValueString(),
 		Protocol:  data.Protocol.ValueString(),
@@ -118,7 +138,7 @@ func (r *resourceResource) Create(ctx context.Context, req resource.CreateReques
 		DomainID:  data.DomainID.ValueString(),
 	}
 
-	created, err := r.client.CreateResource(data.OrgID.ValueString(), res)
+	created, err := r.client.CreateResource(ctx, data.OrgID.ValueString(), res)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating resource", err.Error())
 		return
@@ -135,7 +155,16 @@ func (r *resourceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	res, err := r.client.GetResource(int(data.ID.ValueInt64()))
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, err := r.client.GetResource(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading resource", err.Error())
 		return
@@ -158,6 +187,15 @@ func (r *resourceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	res := &client.Resource{
 		Name:      data.Name.ValueString(),
 		Protocol:  data.Protocol.ValueString(),
@@ -166,7 +204,7 @@ func (r *resourceResource) Update(ctx context.Context, req resource.UpdateReques
 		DomainID:  data.DomainID.ValueString(),
 	}
 
-	_, err := r.client.UpdateResource(int(state.ID.ValueInt64()), res)
+	_, err := r.client.UpdateResource(ctx, int(state.ID.ValueInt64()), res)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating resource", err.Error())
 		return
@@ -183,7 +221,16 @@ func (r *resourceResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.DeleteResource(int(data.ID.ValueInt64()))
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteResource(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting resource", err.Error())
 		return
@@ -191,26 +238,28 @@ func (r *resourceResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *resourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: org_id/id
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: org_id/id. Got: %q", req.ID),
-		)
+	// Import format: org_id/id, org_id/nice:<nice_id> or org_id/host:<fqdn>
+	id, err := parseOrgImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
 		return
 	}
 
-	resID, err := strconv.ParseInt(idParts[1], 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected id to be an integer. Got: %q", idParts[1]),
-		)
-		return
+	resID := id.ID
+	if id.NiceID != "" || id.Host != "" {
+		var res *client.Resource
+		if id.NiceID != "" {
+			res, err = r.client.GetResourceByNiceID(ctx, id.OrgID, id.NiceID)
+		} else {
+			res, err = findResourceByFullDomain(ctx, r.client, id.OrgID, id.Host)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error resolving import identifier", err.Error())
+			return
+		}
+		resID = int64(res.ID)
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), id.OrgID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), resID)...)
 }
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccResource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig("Test App", "acc-app"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_resource.test", "name", "Test App"),
+					resource.TestCheckResourceAttr("pangolin_resource.test", "subdomain", "acc-app"),
+					resource.TestCheckResourceAttrSet("pangolin_resource.test", "id"),
+					resource.TestCheckResourceAttrPair("data.pangolin_resource.test", "id", "pangolin_resource.test", "id"),
+					resource.TestCheckResourceAttrSet("data.pangolin_resource.test", "nice_id"),
+					resource.TestCheckResourceAttrSet("data.pangolin_resource.test", "full_domain"),
+				),
+			},
+			{
+				Config: testAccResourceConfig("Renamed App", "acc-app-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_resource.test", "name", "Renamed App"),
+					resource.TestCheckResourceAttr("pangolin_resource.test", "subdomain", "acc-app-2"),
+				),
+			},
+			{
+				ResourceName:      "pangolin_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/%s", testOrgID, s.RootModule().Resources["pangolin_resource.test"].Primary.ID), nil
+				},
+			},
+			{
+				ResourceName:      "pangolin_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceImportID(func(r *client.Resource) string { return "nice:" + r.NiceID }),
+			},
+			{
+				ResourceName:      "pangolin_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceImportID(func(r *client.Resource) string { return "host:" + r.FullDomain }),
+			},
+		},
+	})
+}
+
+// testAccResourceImportID builds an org_id/<ref> import ID from the resource
+// in state, as the API reports it.
+func testAccResourceImportID(ref func(*client.Resource) string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		id, err := strconv.Atoi(s.RootModule().Resources["pangolin_resource.test"].Primary.ID)
+		if err != nil {
+			return "", err
+		}
+		res, err := client.NewClient(testURL, testToken).GetResource(context.Background(), id)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s/%s", testOrgID, ref(res)), nil
+	}
+}
+
+func testAccResourceConfig(name, subdomain string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+resource "pangolin_resource" "test" {
+  org_id    = %[3]q
+  name      = %[4]q
+  protocol  = "tcp"
+  http      = true
+  subdomain = %[5]q
+  domain_id = "local"
+}
+
+data "pangolin_resource" "test" {
+  org_id = %[3]q
+  id     = pangolin_resource.test.id
+}
+`, testURL, testToken, testOrgID, name, subdomain)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ import (
 
 var _ resource.Resource = &roleResource{}
 var _ resource.ResourceWithImportState = &roleResource{}
+var _ resource.ResourceWithModifyPlan = &roleResource{}
 
 func NewRoleResource() resource.Resource {
 	return &roleResource{}
@@ -28,17 +30,18 @@ type roleResource struct {
 }
 
 type roleResourceModel struct {
-	ID          types.Int64  `tfsdk:"id"`
-	OrgID       types.String `tfsdk:"org_id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID          types.Int64    `tfsdk:"id"`
+	OrgID       types.String   `tfsdk:"org_id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_role"
 }
 
-func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *roleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an organization role.",
 		Attributes: map[string]schema.Attribute{
@@ -50,9 +53,11 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"org_id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The ID of the organization this role belongs to.",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization this role belongs to. Defaults to the provider's `org_id`.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -65,6 +70,9 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				MarkdownDescription: "The description of the role.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -82,6 +90,11 @@ func (r *roleResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = c
 }
 
+// ModifyPlan defaults org_id to the provider's organization.
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data roleResourceModel
 
@@ -90,12 +103,21 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	role := &client.Role{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 	}
 
-	created, err := r.client.CreateRole(data.OrgID.ValueString(), role)
+	created, err := r.client.CreateRole(ctx, data.OrgID.ValueString(), role)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating role", err.Error())
 		return
@@ -114,7 +136,16 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	role, err := r.client.GetRole(data.OrgID.ValueString(), int(data.ID.ValueInt64()))
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	role, err := r.client.GetRole(ctx, data.OrgID.ValueString(), int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading role", err.Error())
 		return
@@ -135,12 +166,21 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	role := &client.Role{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 	}
 
-	_, err := r.client.UpdateRole(data.OrgID.ValueString(), int(state.ID.ValueInt64()), role)
+	_, err := r.client.UpdateRole(ctx, data.OrgID.ValueString(), int(state.ID.ValueInt64()), role)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating role", err.Error())
 		return
@@ -158,7 +198,16 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeleteRole(data.OrgID.ValueString(), int(data.ID.ValueInt64()))
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteRole(ctx, data.OrgID.ValueString(), int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting role", err.Error())
 		return
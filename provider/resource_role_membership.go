@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &roleMembershipResource{}
+var _ resource.ResourceWithImportState = &roleMembershipResource{}
+var _ resource.ResourceWithModifyPlan = &roleMembershipResource{}
+
+// defaultFallbackRoleName is the role users are moved to when a membership is
+// destroyed and no fallback_role_id is configured.
+const defaultFallbackRoleName = client.MemberRoleName
+
+func NewRoleMembershipResource() resource.Resource {
+	return &roleMembershipResource{}
+}
+
+type roleMembershipResource struct {
+	client *client.Client
+}
+
+type roleMembershipResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	OrgID          types.String   `tfsdk:"org_id"`
+	RoleID         types.Int64    `tfsdk:"role_id"`
+	UserID         types.String   `tfsdk:"user_id"`
+	FallbackRoleID types.Int64    `tfsdk:"fallback_role_id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *roleMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_membership"
+}
+
+func (r *roleMembershipResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns an organization user to a role. A user holds a single role per organization, so destroying the membership moves the user to a fallback role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the membership in the format `org_id/role_id/user_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the role.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fallback_role_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the role the user is moved to when the membership is destroyed. Defaults to the organization's `Member` role.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *roleMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan defaults org_id to the provider's organization.
+func (r *roleMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
+func (r *roleMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data roleMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := r.client.AddRoleToUser(ctx, int(data.RoleID.ValueInt64()), data.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error adding user to role", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%d/%s", data.OrgID.ValueString(), data.RoleID.ValueInt64(), data.UserID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *roleMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data roleMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	user, err := r.client.GetOrgUser(ctx, data.OrgID.ValueString(), data.UserID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading organization user", err.Error())
+		return
+	}
+
+	// The user was moved to another role outside of Terraform.
+	if int64(user.RoleID) != data.RoleID.ValueInt64() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *roleMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state roleMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only fallback_role_id can change in place and it is only used on delete.
+	data.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *roleMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data roleMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	user, err := r.client.GetOrgUser(ctx, data.OrgID.ValueString(), data.UserID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading organization user", err.Error())
+		return
+	}
+
+	// Leave the user alone if someone already moved them to another role.
+	if int64(user.RoleID) != data.RoleID.ValueInt64() {
+		return
+	}
+
+	fallbackRoleID := int(data.FallbackRoleID.ValueInt64())
+	if data.FallbackRoleID.IsNull() {
+		roles, err := r.client.ListRoles(ctx, data.OrgID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing roles", err.Error())
+			return
+		}
+		for _, role := range roles {
+			if role.Name == defaultFallbackRoleName {
+				fallbackRoleID = role.ID
+				break
+			}
+		}
+		if fallbackRoleID == 0 {
+			resp.Diagnostics.AddError(
+				"Fallback role not found",
+				fmt.Sprintf("Could not find role %q in organization %q. Set fallback_role_id to choose the role the user is moved to.", defaultFallbackRoleName, data.OrgID.ValueString()),
+			)
+			return
+		}
+	}
+
+	err = r.client.AddRoleToUser(ctx, fallbackRoleID, data.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error removing user from role", err.Error())
+		return
+	}
+}
+
+func (r *roleMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: org_id/role_id/user_id
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: org_id/role_id/user_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	roleID, err := strconv.ParseInt(idParts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected role_id to be an integer. Got: %q", idParts[1]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), idParts[2])...)
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRoleMembership_Basic(t *testing.T) {
+	fake := testAccFake(t)
+	member, _ := fake.RoleByName(testOrgID, "Member")
+	userID := fake.AddUser(testOrgID, "membership-user", member.RoleID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			user, err := client.NewClient(testURL, testToken).GetOrgUser(t.Context(), testOrgID, userID)
+			if err != nil {
+				return err
+			}
+			if user.RoleID != member.RoleID {
+				return fmt.Errorf("expected the user to fall back to the Member role, got role %d", user.RoleID)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleMembershipConfig(userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("pangolin_role_membership.test", "role_id", "pangolin_role.test", "id"),
+					resource.TestCheckResourceAttr("pangolin_role_membership.test", "user_id", userID),
+					resource.TestCheckResourceAttr("data.pangolin_role.test", "member_user_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pangolin_role.test", "member_user_ids.0", userID),
+				),
+			},
+			{
+				ResourceName:      "pangolin_role_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRoleMembershipConfig(userID string) string {
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+resource "pangolin_role" "test" {
+  org_id      = %[3]q
+  name        = "Membership Role"
+  description = "Role for membership tests"
+}
+
+resource "pangolin_role_membership" "test" {
+  org_id  = %[3]q
+  role_id = pangolin_role.test.id
+  user_id = %[4]q
+}
+
+data "pangolin_role" "test" {
+  org_id     = %[3]q
+  name       = pangolin_role.test.name
+  depends_on = [pangolin_role_membership.test]
+}
+`, testURL, testToken, testOrgID, userID)
+}
+
+func TestAccRoleMembership_FallbackRole(t *testing.T) {
+	fake := testAccFake(t)
+	member, _ := fake.RoleByName(testOrgID, "Member")
+	userID := fake.AddUser(testOrgID, "fallback-user", member.RoleID)
+	var fallbackRoleID string
+
+	userRole := func(want func() string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			user, err := client.NewClient(testURL, testToken).GetOrgUser(t.Context(), testOrgID, userID)
+			if err != nil {
+				return err
+			}
+			if got := fmt.Sprint(user.RoleID); got != want() {
+				return fmt.Errorf("expected the user to hold role %s, got role %s", want(), got)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Deleting the fallback role moves its users to the Member role.
+		CheckDestroy: userRole(func() string { return fmt.Sprint(member.RoleID) }),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleMembershipFallbackConfig(userID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("pangolin_role_membership.test", "fallback_role_id", "pangolin_role.fallback", "id"),
+					func(s *terraform.State) error {
+						fallbackRoleID = s.RootModule().Resources["pangolin_role.fallback"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// The user moved to another role outside of Terraform: the
+				// membership is gone and is planned again.
+				PreConfig: func() {
+					if err := client.NewClient(testURL, testToken).AddRoleToUser(t.Context(), member.RoleID, userID); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccRoleMembershipFallbackConfig(userID, true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRoleMembershipFallbackConfig(userID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("pangolin_role_membership.test", "role_id", "pangolin_role.test", "id"),
+				),
+			},
+			{
+				// Destroying only the membership moves the user to the
+				// fallback role.
+				Config: testAccRoleMembershipFallbackConfig(userID, false),
+				Check:  userRole(func() string { return fallbackRoleID }),
+			},
+		},
+	})
+}
+
+func testAccRoleMembershipFallbackConfig(userID string, membership bool) string {
+	config := fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+resource "pangolin_role" "test" {
+  org_id = %[3]q
+  name   = "Fallback Test Role"
+}
+
+resource "pangolin_role" "fallback" {
+  org_id = %[3]q
+  name   = "Fallback Role"
+}
+`, testURL, testToken, testOrgID)
+	if membership {
+		config += fmt.Sprintf(`
+resource "pangolin_role_membership" "test" {
+  org_id           = %[1]q
+  role_id          = pangolin_role.test.id
+  user_id          = %[2]q
+  fallback_role_id = pangolin_role.fallback.id
+}
+`, testOrgID, userID)
+	}
+	return config
+}
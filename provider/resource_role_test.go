@@ -10,7 +10,7 @@ import (
 func TestAccRole_Basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccCassetteFactories(t, "role_basic"),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
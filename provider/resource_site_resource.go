@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +22,7 @@ import (
 
 var _ resource.Resource = &siteResource{}
 var _ resource.ResourceWithImportState = &siteResource{}
+var _ resource.ResourceWithModifyPlan = &siteResource{}
 
 func NewSiteResource() resource.Resource {
 	return &siteResource{}
@@ -32,28 +33,29 @@ type siteResource struct {
 }
 
 type siteResourceModel struct {
-	ID                 types.Int64  `tfsdk:"id"`
-	NiceID             types.String `tfsdk:"nice_id"`
-	OrgID              types.String `tfsdk:"org_id"`
-	Name               types.String `tfsdk:"name"`
-	Mode               types.String `tfsdk:"mode"`
-	SiteID             types.Int64  `tfsdk:"site_id"`
-	Destination        types.String `tfsdk:"destination"`
-	Enabled            types.Bool   `tfsdk:"enabled"`
-	Alias              types.String `tfsdk:"alias"`
-	UserIDs            types.List   `tfsdk:"user_ids"`
-	RoleIDs            types.List   `tfsdk:"role_ids"`
-	ClientIDs          types.List   `tfsdk:"client_ids"`
-	TCPPortRangeString types.String `tfsdk:"tcp_port_range_string"`
-	UDPPortRangeString types.String `tfsdk:"udp_port_range_string"`
-	DisableIcmp        types.Bool   `tfsdk:"disable_icmp"`
+	ID                 types.Int64    `tfsdk:"id"`
+	NiceID             types.String   `tfsdk:"nice_id"`
+	OrgID              types.String   `tfsdk:"org_id"`
+	Name               types.String   `tfsdk:"name"`
+	Mode               types.String   `tfsdk:"mode"`
+	SiteID             types.Int64    `tfsdk:"site_id"`
+	Destination        types.String   `tfsdk:"destination"`
+	Enabled            types.Bool     `tfsdk:"enabled"`
+	Alias              types.String   `tfsdk:"alias"`
+	UserIDs            types.List     `tfsdk:"user_ids"`
+	RoleIDs            types.List     `tfsdk:"role_ids"`
+	ClientIDs          types.List     `tfsdk:"client_ids"`
+	TCPPortRangeString types.String   `tfsdk:"tcp_port_range_string"`
+	UDPPortRangeString types.String   `tfsdk:"udp_port_range_string"`
+	DisableIcmp        types.Bool     `tfsdk:"disable_icmp"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *siteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_site_resource"
 }
 
-func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *siteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a site resource (Host or CIDR mode).",
 		Attributes: map[string]schema.Attribute{
@@ -72,9 +74,11 @@ func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"org_id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The ID of the organization.",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the organization. Defaults to the provider's `org_id`.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -147,6 +151,9 @@ func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				MarkdownDescription: "Whether to disable ICMP for this resource.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -164,6 +171,11 @@ func (r *siteResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = c
 }
 
+// ModifyPlan defaults org_id to the provider's organization.
+func (r *siteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
 func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data siteResourceModel
 
@@ -172,6 +184,15 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	res := &client.SiteResource{
 		Name:               data.Name.ValueString(),
 		Mode:               data.Mode.ValueString(),
@@ -196,7 +217,7 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	created, err := r.client.CreateSiteResource(data.OrgID.ValueString(), res)
+	created, err := r.client.CreateSiteResource(ctx, data.OrgID.ValueString(), res)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating site resource", err.Error())
 		return
@@ -216,7 +237,16 @@ func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	res, err := r.client.GetSiteResource(data.OrgID.ValueString(), int(data.SiteID.ValueInt64()), int(data.ID.ValueInt64()))
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, err := r.client.GetSiteResource(ctx, data.OrgID.ValueString(), int(data.SiteID.ValueInt64()), int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading site resource", err.Error())
 		return
@@ -224,6 +254,9 @@ func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	data.Name = types.StringValue(res.Name)
 	data.Mode = types.StringValue(res.Mode)
+	if res.SiteID != 0 {
+		data.SiteID = types.Int64Value(int64(res.SiteID))
+	}
 	data.Destination = types.StringValue(res.Destination)
 	data.Enabled = types.BoolValue(res.Enabled)
 	if res.Alias != nil {
@@ -235,21 +268,21 @@ func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.UDPPortRangeString = types.StringValue(res.UDPPortRangeString)
 	data.DisableIcmp = types.BoolValue(res.DisableIcmp)
 
-	roleIDs, err := r.client.GetSiteResourceRoles(int(data.ID.ValueInt64()))
+	roleIDs, err := r.client.GetSiteResourceRoles(ctx, int(data.ID.ValueInt64()))
 	if err == nil {
 		roleIDsList, diags := types.ListValueFrom(ctx, types.Int64Type, roleIDs)
 		resp.Diagnostics.Append(diags...)
 		data.RoleIDs = roleIDsList
 	}
 
-	userIDs, err := r.client.GetSiteResourceUsers(int(data.ID.ValueInt64()))
+	userIDs, err := r.client.GetSiteResourceUsers(ctx, int(data.ID.ValueInt64()))
 	if err == nil {
 		userIDsList, diags := types.ListValueFrom(ctx, types.StringType, userIDs)
 		resp.Diagnostics.Append(diags...)
 		data.UserIDs = userIDsList
 	}
 
-	clientIDs, err := r.client.GetSiteResourceClients(int(data.ID.ValueInt64()))
+	clientIDs, err := r.client.GetSiteResourceClients(ctx, int(data.ID.ValueInt64()))
 	if err == nil {
 		clientIDsList, diags := types.ListValueFrom(ctx, types.Int64Type, clientIDs)
 		resp.Diagnostics.Append(diags...)
@@ -268,6 +301,15 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	res := &client.SiteResource{
 		Name:               data.Name.ValueString(),
 		Mode:               data.Mode.ValueString(),
@@ -292,7 +334,7 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	_, err := r.client.UpdateSiteResource(int(state.ID.ValueInt64()), res)
+	_, err := r.client.UpdateSiteResource(ctx, int(state.ID.ValueInt64()), res)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating site resource", err.Error())
 		return
@@ -311,7 +353,16 @@ func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeleteSiteResource(int(data.ID.ValueInt64()))
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteSiteResource(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting site resource", err.Error())
 		return
@@ -319,26 +370,68 @@ func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *siteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: org_id/id
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: org_id/id. Got: %q", req.ID),
-		)
+	// Import format: org_id/id, org_id/nice:<nice_id>,
+	// org_id/site_id/nice:<nice_id> or org_id/host:<fqdn>
+	id, err := parseSiteImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
 		return
 	}
 
-	resID, err := strconv.ParseInt(idParts[1], 10, 64)
+	if id.NiceID != "" || id.Host != "" {
+		res, err := r.findSiteResource(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Error resolving import identifier", err.Error())
+			return
+		}
+		id.ID = int64(res.ID)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), int64(res.SiteID))...)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), id.OrgID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.ID)...)
+}
+
+// findSiteResource resolves a nice ID, or a host matching the destination or
+// alias, to a site resource. Unless the import identifier names the site, this
+// searches every site resource of the organization and fails on ambiguity.
+func (r *siteResource) findSiteResource(ctx context.Context, id orgImportID) (*client.SiteResource, error) {
+	if id.SiteID != 0 {
+		return r.client.GetSiteResourceByNiceID(ctx, id.OrgID, int(id.SiteID), id.NiceID)
+	}
+
+	resources, err := r.client.ListSiteResources(ctx, id.OrgID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected id to be an integer. Got: %q", idParts[1]),
-		)
-		return
+		return nil, err
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), resID)...)
+	what := fmt.Sprintf("nice ID %q", id.NiceID)
+	if id.Host != "" {
+		what = fmt.Sprintf("host %q", id.Host)
+	}
+
+	var matches []client.SiteResource
+	for _, res := range resources {
+		switch {
+		case id.NiceID != "" && res.NiceID == id.NiceID:
+		case id.Host != "" && strings.EqualFold(res.Destination, id.Host):
+		case id.Host != "" && res.Alias != nil && strings.EqualFold(strings.TrimSuffix(*res.Alias, "."), id.Host):
+		default:
+			continue
+		}
+		matches = append(matches, res)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("could not find site resource with %s in organization %q", what, id.OrgID)
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, res := range matches {
+			ids[i] = fmt.Sprintf("%d (site %d, %s)", res.ID, res.SiteID, res.NiceID)
+		}
+		return nil, fmt.Errorf("%d site resources match %s in organization %q: %s; use org_id/id or org_id/site_id/nice:<nice_id> instead", len(matches), what, id.OrgID, strings.Join(ids, ", "))
+	}
 }
@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"testing"
 
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccSiteResource_Basic(t *testing.T) {
@@ -36,10 +40,55 @@ func TestAccSiteResource_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("pangolin_site_resource.test", "destination", "10.0.0.0/24"),
 				),
 			},
+			{
+				ResourceName:      "pangolin_site_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSiteResourceImportID(func(r *client.SiteResource) string { return strconv.Itoa(r.ID) }),
+			},
+			{
+				ResourceName:      "pangolin_site_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSiteResourceImportID(func(r *client.SiteResource) string { return "nice:" + r.NiceID }),
+			},
+			{
+				ResourceName:      "pangolin_site_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSiteResourceImportID(func(r *client.SiteResource) string {
+					return fmt.Sprintf("%d/nice:%s", r.SiteID, r.NiceID)
+				}),
+			},
+			{
+				ResourceName:      "pangolin_site_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSiteResourceImportID(func(r *client.SiteResource) string { return "host:" + *r.Alias }),
+			},
 		},
 	})
 }
 
+// testAccSiteResourceImportID builds an org_id/<ref> import ID from the site
+// resource in state, as the API reports it.
+func testAccSiteResourceImportID(ref func(*client.SiteResource) string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		id, err := strconv.Atoi(s.RootModule().Resources["pangolin_site_resource.test"].Primary.ID)
+		if err != nil {
+			return "", err
+		}
+		res, err := client.NewClient(testURL, testToken).GetSiteResource(context.Background(), testOrgID, 0, id)
+		if err != nil {
+			return "", err
+		}
+		if res.Alias == nil {
+			return "", fmt.Errorf("site resource %d has no alias", id)
+		}
+		return fmt.Sprintf("%s/%s", testOrgID, ref(res)), nil
+	}
+}
+
 func testAccSiteResourceConfig(siteID int, name, mode, destination, alias string) string {
 	return fmt.Sprintf(`
 provider "pangolin" {
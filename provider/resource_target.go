@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,38 +28,39 @@ type targetResource struct {
 }
 
 type targetResourceModel struct {
-	ID                  types.Int64  `tfsdk:"id"`
-	ResourceID          types.Int64  `tfsdk:"resource_id"`
-	SiteID              types.Int64  `tfsdk:"site_id"`
-	IP                  types.String `tfsdk:"ip"`
-	Port                types.Int64  `tfsdk:"port"`
-	Method              types.String `tfsdk:"method"`
-	Enabled             types.Bool   `tfsdk:"enabled"`
-	HCEnabled           types.Bool   `tfsdk:"hc_enabled"`
-	HCPath              types.String `tfsdk:"hc_path"`
-	HCScheme            types.String `tfsdk:"hc_scheme"`
-	HCMode              types.String `tfsdk:"hc_mode"`
-	HCHostname          types.String `tfsdk:"hc_hostname"`
-	HCPort              types.Int64  `tfsdk:"hc_port"`
-	HCInterval          types.Int64  `tfsdk:"hc_interval"`
-	HCUnhealthyInterval types.Int64  `tfsdk:"hc_unhealthy_interval"`
-	HCTimeout           types.Int64  `tfsdk:"hc_timeout"`
-	HCFollowRedirects   types.Bool   `tfsdk:"hc_follow_redirects"`
-	HCMethod            types.String `tfsdk:"hc_method"`
-	HCStatus            types.Int64  `tfsdk:"hc_status"`
-	HCTlsServerName     types.String `tfsdk:"hc_tls_server_name"`
-	Path                types.String `tfsdk:"path"`
-	PathMatchType       types.String `tfsdk:"path_match_type"`
-	RewritePath         types.String `tfsdk:"rewrite_path"`
-	RewritePathType     types.String `tfsdk:"rewrite_path_type"`
-	Priority            types.Int64  `tfsdk:"priority"`
+	ID                  types.Int64    `tfsdk:"id"`
+	ResourceID          types.Int64    `tfsdk:"resource_id"`
+	SiteID              types.Int64    `tfsdk:"site_id"`
+	IP                  types.String   `tfsdk:"ip"`
+	Port                types.Int64    `tfsdk:"port"`
+	Method              types.String   `tfsdk:"method"`
+	Enabled             types.Bool     `tfsdk:"enabled"`
+	HCEnabled           types.Bool     `tfsdk:"hc_enabled"`
+	HCPath              types.String   `tfsdk:"hc_path"`
+	HCScheme            types.String   `tfsdk:"hc_scheme"`
+	HCMode              types.String   `tfsdk:"hc_mode"`
+	HCHostname          types.String   `tfsdk:"hc_hostname"`
+	HCPort              types.Int64    `tfsdk:"hc_port"`
+	HCInterval          types.Int64    `tfsdk:"hc_interval"`
+	HCUnhealthyInterval types.Int64    `tfsdk:"hc_unhealthy_interval"`
+	HCTimeout           types.Int64    `tfsdk:"hc_timeout"`
+	HCFollowRedirects   types.Bool     `tfsdk:"hc_follow_redirects"`
+	HCMethod            types.String   `tfsdk:"hc_method"`
+	HCStatus            types.Int64    `tfsdk:"hc_status"`
+	HCTlsServerName     types.String   `tfsdk:"hc_tls_server_name"`
+	Path                types.String   `tfsdk:"path"`
+	PathMatchType       types.String   `tfsdk:"path_match_type"`
+	RewritePath         types.String   `tfsdk:"rewrite_path"`
+	RewritePathType     types.String   `tfsdk:"rewrite_path_type"`
+	Priority            types.Int64    `tfsdk:"priority"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *targetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_target"
 }
 
-func (r *targetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *targetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a backend target for a resource.",
 		Attributes: map[string]schema.Attribute{
@@ -171,6 +173,9 @@ func (r *targetResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				MarkdownDescription: "The priority of the target.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -196,6 +201,15 @@ func (r *targetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
 	target := &client.Target{
 		SiteID:  int(data.SiteID.ValueInt64()),
 		IP:      data.IP.ValueString(),
@@ -212,7 +226,7 @@ func (r *targetResource) Create(ctx context.Context, req resource.CreateRequest,
 		target.HCEnabled = &b
 	}
 
-	created, err := r.client.CreateTarget(int(data.ResourceID.ValueInt64()), target)
+	created, err := r.client.CreateTarget(ctx, int(data.ResourceID.ValueInt64()), target)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating target", err.Error())
 		return
@@ -231,7 +245,16 @@ func (r *targetResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	target, err := r.client.GetTarget(int(data.ID.ValueInt64()))
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	target, err := r.client.GetTarget(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading target", err.Error())
 		return
@@ -254,6 +277,15 @@ func (r *targetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	target := &client.Target{
 		SiteID:  int(data.SiteID.ValueInt64()),
 		IP:      data.IP.ValueString(),
@@ -261,7 +293,7 @@ func (r *targetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Enabled: data.Enabled.ValueBool(),
 	}
 
-	_, err := r.client.UpdateTarget(int(state.ID.ValueInt64()), target)
+	_, err := r.client.UpdateTarget(ctx, int(state.ID.ValueInt64()), target)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating target", err.Error())
 		return
@@ -279,7 +311,16 @@ func (r *targetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteTarget(int(data.ID.ValueInt64()))
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteTarget(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting target", err.Error())
 		return
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &userTwoFactorResource{}
+var _ resource.ResourceWithImportState = &userTwoFactorResource{}
+var _ resource.ResourceWithModifyPlan = &userTwoFactorResource{}
+
+// enrollmentPollInterval is how often wait_for_enrollment checks the user.
+const enrollmentPollInterval = 5 * time.Second
+
+// defaultEnrollmentTimeout bounds wait_for_enrollment when the timeouts block
+// sets no create or update timeout.
+const defaultEnrollmentTimeout = 10 * time.Minute
+
+func NewUserTwoFactorResource() resource.Resource {
+	return &userTwoFactorResource{}
+}
+
+type userTwoFactorResource struct {
+	client *client.Client
+}
+
+type userTwoFactorResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	UserID            types.String   `tfsdk:"user_id"`
+	Required          types.Bool     `tfsdk:"required"`
+	WaitForEnrollment types.Bool     `tfsdk:"wait_for_enrollment"`
+	TwoFactorEnabled  types.Bool     `tfsdk:"two_factor_enabled"`
+	SetupRequested    types.Bool     `tfsdk:"setup_requested"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *userTwoFactorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_two_factor"
+}
+
+func (r *userTwoFactorResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Requires a user to enroll in two-factor authentication. " +
+			"`two_factor_enabled` reports whether the user has enrolled; plans warn while enrollment is pending, " +
+			"and `wait_for_enrollment` makes applies wait for it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"required": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the user must enroll in two-factor authentication.",
+			},
+			"wait_for_enrollment": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether applies wait until the user has enrolled, up to the `create` or `update` timeout (10 minutes by default). Only used while `required` is true.",
+			},
+			"two_factor_enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user has completed two-factor enrollment.",
+			},
+			"setup_requested": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user will be asked to set up two-factor authentication on their next sign in.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *userTwoFactorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan warns while a required enrollment is still pending, since the
+// apply cannot complete it on the user's behalf.
+func (r *userTwoFactorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state userTwoFactorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Required.ValueBool() && !plan.WaitForEnrollment.ValueBool() && !state.TwoFactorEnabled.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Two-Factor Enrollment Pending",
+			fmt.Sprintf("User %q has not enrolled in two-factor authentication yet. They will be asked to on their next sign in.", state.UserID.ValueString()),
+		)
+	}
+}
+
+func (r *userTwoFactorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data userTwoFactorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userTwoFactorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data userTwoFactorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, readTimeout)
+	defer cancel()
+
+	user, err := r.client.GetUser(ctx, data.UserID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	data.TwoFactorEnabled = types.BoolValue(user.TwoFactorEnabled)
+	data.SetupRequested = types.BoolValue(user.TwoFactorSetupRequested)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userTwoFactorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data userTwoFactorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userTwoFactorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data userTwoFactorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Withdraw a pending request. An already enrolled user keeps two-factor authentication.
+	err := r.client.SetUserTwoFactorSetupRequested(ctx, data.UserID.ValueString(), false)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error updating user two-factor authentication", err.Error())
+		return
+	}
+}
+
+func (r *userTwoFactorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: user_id
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("required"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_enrollment"), false)...)
+}
+
+// apply requests enrollment from a user that has not enrolled yet and records
+// the resulting status. Create and Update share it since both converge on the
+// same desired state.
+func (r *userTwoFactorResource) apply(ctx context.Context, data *userTwoFactorResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	userID := data.UserID.ValueString()
+
+	user, err := r.client.GetUser(ctx, userID)
+	if err != nil {
+		diags.AddError("Error reading user", err.Error())
+		return diags
+	}
+
+	if data.Required.ValueBool() != user.TwoFactorSetupRequested && !user.TwoFactorEnabled {
+		if err := r.client.SetUserTwoFactorSetupRequested(ctx, userID, data.Required.ValueBool()); err != nil {
+			diags.AddError("Error updating user two-factor authentication", err.Error())
+			return diags
+		}
+		user.TwoFactorSetupRequested = data.Required.ValueBool()
+	}
+
+	if data.Required.ValueBool() && data.WaitForEnrollment.ValueBool() && !user.TwoFactorEnabled {
+		user, err = r.waitForEnrollment(ctx, userID)
+		if err != nil {
+			diags.AddError(
+				"Two-Factor Enrollment Not Completed",
+				fmt.Sprintf("User %q did not enroll in two-factor authentication in time: %s", userID, err),
+			)
+			return diags
+		}
+	}
+
+	data.ID = types.StringValue(userID)
+	data.TwoFactorEnabled = types.BoolValue(user.TwoFactorEnabled)
+	data.SetupRequested = types.BoolValue(user.TwoFactorSetupRequested)
+
+	return diags
+}
+
+// waitForEnrollment polls the user until they have enrolled in two-factor
+// authentication, or ctx ends.
+func (r *userTwoFactorResource) waitForEnrollment(ctx context.Context, userID string) (*client.User, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultEnrollmentTimeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(enrollmentPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		user, err := r.client.GetUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user.TwoFactorEnabled {
+			return user, nil
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserTwoFactor_Basic(t *testing.T) {
+	fake := testAccFake(t)
+	member, _ := fake.RoleByName(testOrgID, "Member")
+	userID := fake.AddUser(testOrgID, "two-factor-user", member.RoleID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserTwoFactorConfig(userID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_user_two_factor.test", "required", "true"),
+					resource.TestCheckResourceAttr("pangolin_user_two_factor.test", "setup_requested", "true"),
+					resource.TestCheckResourceAttr("pangolin_user_two_factor.test", "two_factor_enabled", "false"),
+				),
+			},
+			{
+				// A pending enrollment is not drift.
+				Config:   testAccUserTwoFactorConfig(userID, ""),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccUserTwoFactorConfig(userID, "update = \"1s\""),
+				ExpectError: regexp.MustCompile(`Two-Factor Enrollment Not Completed`),
+			},
+			{
+				PreConfig: func() { fake.EnrollTwoFactor(userID) },
+				Config:    testAccUserTwoFactorConfig(userID, "update = \"1m\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pangolin_user_two_factor.test", "required", "true"),
+					resource.TestCheckResourceAttr("pangolin_user_two_factor.test", "wait_for_enrollment", "true"),
+					resource.TestCheckResourceAttr("pangolin_user_two_factor.test", "two_factor_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            "pangolin_user_two_factor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_enrollment", "timeouts"},
+			},
+		},
+	})
+}
+
+// testAccUserTwoFactorConfig waits for enrollment when timeouts is set.
+func testAccUserTwoFactorConfig(userID string, timeouts string) string {
+	wait := ""
+	if timeouts != "" {
+		wait = fmt.Sprintf(`
+  wait_for_enrollment = true
+
+  timeouts {
+    %s
+  }`, timeouts)
+	}
+	return fmt.Sprintf(`
+provider "pangolin" {
+  base_url = %[1]q
+  token    = %[2]q
+}
+
+resource "pangolin_user_two_factor" "test" {
+  user_id  = %[3]q
+  required = true
+%[4]s
+}
+`, testURL, testToken, userID, wait)
+}
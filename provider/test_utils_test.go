@@ -1,17 +1,32 @@
 package provider
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/groteck/terraform-provider-pangolin/internal/client"
+	"github.com/groteck/terraform-provider-pangolin/internal/fakeserver"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 const (
 	testOrgID = "test-tf"
-	testToken = "f1l1v68jvs2j8ix.34fvctzav5t46kdnchztxz6u5ajfxt5wobs4iulv"
-	testURL   = "http://localhost:3003/v1" // Integration API port and prefix
+
+	// Token of the gold database used by the docker test environment.
+	liveTestToken = "f1l1v68jvs2j8ix.34fvctzav5t46kdnchztxz6u5ajfxt5wobs4iulv"
+)
+
+// Acceptance tests run against an in-memory fake Pangolin unless
+// PANGOLIN_TEST_URL points them at a real Integration API, e.g.
+// http://localhost:3003/v1 for the docker test environment.
+var (
+	testURL   = os.Getenv("PANGOLIN_TEST_URL")
+	testToken = os.Getenv("PANGOLIN_TEST_TOKEN")
+
+	// testFake is the fake server, or nil when testing against a real API.
+	testFake *fakeserver.Server
 )
 
 var (
@@ -20,18 +35,94 @@ var (
 	}
 )
 
+func TestMain(m *testing.M) {
+	if testURL == "" {
+		testFake = fakeserver.New()
+		testFake.AddOrg(testOrgID)
+		testFake.AddDomain(testOrgID, "local", "test-tf.localhost")
+		testFake.AddSite(testOrgID, "Test Site")
+		testURL, testToken = testFake.BaseURL(), fakeserver.Token
+	} else if testToken == "" {
+		testToken = liveTestToken
+	}
+
+	code := m.Run()
+	if testFake != nil {
+		testFake.Close()
+	}
+	os.Exit(code)
+}
+
+// testAccCassetteFactories returns provider factories whose client records
+// to or replays from tests/fixtures/cassettes/<name>.yaml. With
+// PANGOLIN_RECORD set, traffic to the real API under test is recorded;
+// recording the fake is refused, since a cassette must capture Pangolin's
+// own responses. Otherwise, when testing against the fake, the cassette is
+// replayed instead and the test fails if it is missing. A real API without
+// PANGOLIN_RECORD is used directly.
+func testAccCassetteFactories(t *testing.T, name string) map[string]func() (tfprotov6.ProviderServer, error) {
+	path := filepath.Join("..", "tests", "fixtures", "cassettes", name+".yaml")
+
+	mode := client.ModeReplay
+	switch _, err := os.Stat(path); {
+	case os.Getenv("PANGOLIN_RECORD") != "":
+		if testFake != nil {
+			t.Fatal("cassettes are recorded against a real Pangolin; set PANGOLIN_TEST_URL or run make test-record")
+		}
+		mode = client.ModeRecord
+	case testFake == nil:
+		return testAccProtoV6ProviderFactories
+	case err != nil:
+		t.Fatalf("cassette %s is missing; record it with PANGOLIN_RECORD=1: %v", path, err)
+	}
+
+	rec, err := client.NewRecorder(path, mode, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if t.Failed() {
+			return
+		}
+		if err := rec.Save(); err != nil {
+			t.Errorf("saving cassette: %v", err)
+		}
+	})
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"pangolin": providerserver.NewProtocol6WithError(&pangolinProvider{version: "test", transport: rec}),
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	// Verify API is reachable
 	c := client.NewClient(testURL, testToken)
-	_, err := c.ListSites(testOrgID)
+	_, err := c.ListSites(t.Context(), testOrgID)
 	if err != nil {
 		t.Fatalf("API unreachable or invalid credentials: %v", err)
 	}
 }
 
+// testAccPreCheckLive skips tests of endpoints the fake server does not
+// implement.
+func testAccPreCheckLive(t *testing.T) {
+	if testFake != nil {
+		t.Skip("needs a real Pangolin API; set PANGOLIN_TEST_URL")
+	}
+	testAccPreCheck(t)
+}
+
+// testAccFake returns the fake server for tests that seed data directly, and
+// skips them when testing against a real API.
+func testAccFake(t *testing.T) *fakeserver.Server {
+	if testFake == nil {
+		t.Skip("seeds the fake server; unset PANGOLIN_TEST_URL")
+	}
+	return testFake
+}
+
 func getTestSiteID(t *testing.T) int {
 	c := client.NewClient(testURL, testToken)
-	sites, err := c.ListSites(testOrgID)
+	sites, err := c.ListSites(t.Context(), testOrgID)
 	if err != nil {
 		t.Fatalf("failed to list sites: %v", err)
 	}
@@ -41,7 +132,7 @@ func getTestSiteID(t *testing.T) int {
 	}
 
 	// Create one if it doesn't exist
-	site, err := c.CreateSite(testOrgID, "Test Site")
+	site, err := c.CreateSite(t.Context(), testOrgID, "Test Site")
 	if err != nil {
 		t.Fatalf("failed to create test site: %v", err)
 	}
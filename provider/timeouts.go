@@ -0,0 +1,16 @@
+package provider
+
+import (
+	"context"
+	"time"
+)
+
+// withTimeout bounds ctx by timeout, the value of an operation in the
+// resource's timeouts block read with a default of zero. Without one, ctx is
+// returned unchanged, and the client limits each request by itself.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
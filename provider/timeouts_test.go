@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWithTimeout(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	}
+	value := timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"create": types.StringValue("10m"),
+		"read":   types.StringNull(),
+		"update": types.StringNull(),
+		"delete": types.StringNull(),
+	})}
+
+	create, diags := value.Create(t.Context(), 0)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	ctx, cancel := withTimeout(t.Context(), create)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > 10*time.Minute || time.Until(deadline) < 9*time.Minute {
+		t.Errorf("create: deadline %v, %t", deadline, ok)
+	}
+
+	noBlock := timeouts.Value{Object: types.ObjectNull(attrTypes)}
+	for name, value := range map[string]timeouts.Value{"unset operation": value, "no block": noBlock} {
+		timeout, diags := value.Delete(t.Context(), 0)
+		if diags.HasError() {
+			t.Fatalf("%s: %v", name, diags)
+		}
+		ctx, cancel := withTimeout(t.Context(), timeout)
+		cancel()
+		if _, ok := ctx.Deadline(); ok {
+			t.Errorf("%s: unexpected deadline", name)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHTTPURLValidator(t *testing.T) {
+	cases := map[string]bool{
+		"https://keycloak.example.com/realms/main/protocol/openid-connect/auth": true,
+		"http://localhost:8080/token":                                           true,
+		"ftp://example.com":                                                     false,
+		"/relative/path":                                                        false,
+		"https://":                                                              false,
+	}
+
+	for value, valid := range cases {
+		resp := &validator.StringResponse{}
+		httpURLValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("auth_url"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("%q: expected valid=%t, got diagnostics: %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestJMESPathValidator(t *testing.T) {
+	cases := map[string]bool{
+		"sub":                   true,
+		"preferred_username":    true,
+		"realm_access.roles[0]": true,
+		"contains(groups, 'admins') && 'Admin' || 'Member'": true,
+		"groups[": false,
+		"a..b":    false,
+	}
+
+	for value, valid := range cases {
+		resp := &validator.StringResponse{}
+		jmespathValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("identifier_path"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("%q: expected valid=%t, got diagnostics: %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestRegexValidator(t *testing.T) {
+	cases := map[string]bool{
+		"^web-":        true,
+		"(?i)grafana$": true,
+		"prod-[":       false,
+	}
+
+	for value, valid := range cases {
+		resp := &validator.StringResponse{}
+		regexValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("name_regex"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("%q: expected valid=%t, got diagnostics: %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestTimeValidators(t *testing.T) {
+	cases := []struct {
+		v     validator.String
+		value string
+		valid bool
+	}{
+		{rfc3339Validator{}, "2025-01-02T15:04:05Z", true},
+		{rfc3339Validator{}, "2025-01-02T15:04:05+02:00", true},
+		{rfc3339Validator{}, "2025-01-02", false},
+		{durationValidator{}, "24h", true},
+		{durationValidator{}, "90m", true},
+		{durationValidator{}, "-1h", false},
+		{durationValidator{}, "1d", false},
+	}
+
+	for _, tc := range cases {
+		resp := &validator.StringResponse{}
+		tc.v.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("value"),
+			ConfigValue: types.StringValue(tc.value),
+		}, resp)
+
+		if resp.Diagnostics.HasError() == tc.valid {
+			t.Errorf("%T %q: expected valid=%t, got diagnostics: %v", tc.v, tc.value, tc.valid, resp.Diagnostics)
+		}
+	}
+}